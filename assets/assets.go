@@ -0,0 +1,53 @@
+// Package assets embeds the static files used by templaui, such as the
+// Bulma stylesheet, and serves them over HTTP so applications don't
+// depend on a CDN.
+//
+// Mount Handler under Prefix and reference files with Path, which
+// returns content-hashed URLs that can be cached forever:
+//
+//	mux.Handle(assets.Prefix, assets.Handler())
+//
+// templaui.BulmaCSS and templaui.Document link the embedded stylesheet
+// when configured with UseEmbedded.
+package assets
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+//go:generate go run ./internal/fetch -out dist
+
+// Prefix is the URL path under which Handler is expected to be mounted.
+const Prefix = "/assets/"
+
+// Bulma is the name of the embedded Bulma stylesheet.
+const Bulma = "bulma.min.css"
+
+//go:embed all:dist
+var dist embed.FS
+
+// Default serves the files embedded in this package under Prefix.
+var Default = New(mustSub(dist, "dist"), Prefix)
+
+// Handler returns the http.Handler serving the embedded files.
+func Handler() http.Handler {
+	return Default
+}
+
+// Path returns the content-hashed URL of the embedded file with the
+// given name, failing when it isn't embedded, e.g. because dist wasn't
+// generated.
+func Path(name string) (string, error) {
+	return Default.Path(name)
+}
+
+// Helper function to return a subtree of an embedded file system
+func mustSub(fsys fs.FS, dir string) fs.FS {
+	sub, err := fs.Sub(fsys, dir)
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
@@ -0,0 +1,111 @@
+// Command fetch downloads the Bulma stylesheet embedded by the assets
+// package, verifies it against its Subresource Integrity hash and writes
+// it along with its precompressed variants. The hash defaults to the
+// one registered for the version in templaui. It is run by go generate:
+//
+//	go generate ./assets
+//
+// The brotli variant is written when the brotli command is installed.
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha512"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/alexferl/templaui"
+)
+
+func main() {
+	version := flag.String("version", templaui.BulmaVersion, "Bulma version to download")
+	integrity := flag.String("integrity", "", "expected SRI hash of the stylesheet (defaults to the registered hash of the version)")
+	out := flag.String("out", "dist", "output directory")
+	flag.Parse()
+
+	if *integrity == "" {
+		var err error
+		if *integrity, err = templaui.BulmaIntegrity(*version); err != nil {
+			log.Fatal(err)
+		}
+	}
+	url, err := templaui.BulmaURL(templaui.CDNJS, *version)
+	if err != nil {
+		log.Fatal(err)
+	}
+	data, err := download(url)
+	if err != nil {
+		log.Fatal(err)
+	}
+	sum := sha512.Sum512(data)
+	if got := "sha512-" + base64.StdEncoding.EncodeToString(sum[:]); got != *integrity {
+		log.Fatalf("integrity mismatch for %s: expected %s, got %s", url, *integrity, got)
+	}
+
+	name := filepath.Join(*out, "bulma.min.css")
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(name, data, 0o644); err != nil {
+		log.Fatal(err)
+	}
+	if err := writeGzip(name+".gz", data); err != nil {
+		log.Fatal(err)
+	}
+	if err := writeBrotli(name, name+".br"); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Helper function to download a file
+func download(url string) ([]byte, error) {
+	client := &http.Client{Timeout: time.Minute}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("downloading %s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// Helper function to write the gzip variant of a file
+func writeGzip(name string, data []byte) error {
+	var buf bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return err
+	}
+	if _, err := zw.Write(data); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	return os.WriteFile(name, buf.Bytes(), 0o644)
+}
+
+// Helper function to write the brotli variant of a file with the brotli command
+func writeBrotli(src, dst string) error {
+	if _, err := exec.LookPath("brotli"); err != nil {
+		log.Printf("brotli not found, skipping %s", dst)
+		if err := os.Remove(dst); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	cmd := exec.Command("brotli", "--best", "--force", "--output="+dst, src)
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
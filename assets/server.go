@@ -0,0 +1,180 @@
+package assets
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Server serves the files of a file system under content-hashed names.
+//
+// A file named bulma.min.css is served as bulma.min.<hash>.css, where the
+// hash is derived from its content, with a one year immutable
+// Cache-Control header, and under its plain name with a no-cache header,
+// so browsers revalidate it with its ETag. Precompressed variants stored
+// next to a file with a .br or .gz extension are served to clients
+// accepting them; files without a gzip variant are compressed once when
+// first requested.
+type Server struct {
+	fsys   fs.FS
+	prefix string
+	load   func() *index
+}
+
+// index holds the files of a Server by hashed and plain name.
+type index struct {
+	files  map[string]*file
+	hashed map[string]string
+}
+
+// file is a servable file and its encoded variants.
+type file struct {
+	name        string
+	hash        string
+	contentType string
+	variants    map[string][]byte
+}
+
+// New returns a Server for the files of fsys, linked under prefix.
+func New(fsys fs.FS, prefix string) *Server {
+	s := &Server{fsys: fsys, prefix: prefix}
+	s.load = sync.OnceValue(s.index)
+	return s
+}
+
+// Path returns the content-hashed URL of the file with the given name.
+// It fails with an error wrapping fs.ErrNotExist when the file doesn't
+// exist, rather than returning a URL the Server would answer with 404.
+func (s *Server) Path(name string) (string, error) {
+	hashed, ok := s.load().hashed[name]
+	if !ok {
+		return "", fmt.Errorf("assets: %s: %w", name, fs.ErrNotExist)
+	}
+	return s.prefix + hashed, nil
+}
+
+// ServeHTTP serves the file named by the last element of the request path.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	idx := s.load()
+	name := path.Base(r.URL.Path)
+	f, ok := idx.files[name]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	h := w.Header()
+	if name == f.name {
+		h.Set("Cache-Control", "no-cache")
+	} else {
+		h.Set("Cache-Control", "public, max-age=31536000, immutable")
+	}
+	h.Set("Content-Type", f.contentType)
+	h.Set("Vary", "Accept-Encoding")
+	h.Set("X-Content-Type-Options", "nosniff")
+
+	encoding := negotiate(r.Header.Get("Accept-Encoding"), f.variants)
+	etag := f.hash
+	if encoding != "" {
+		h.Set("Content-Encoding", encoding)
+		etag += "-" + encoding
+	}
+	h.Set("ETag", `"`+etag+`"`)
+
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(f.variants[encoding]))
+}
+
+// Helper function to load and hash the files of the file system
+func (s *Server) index() *index {
+	idx := &index{files: map[string]*file{}, hashed: map[string]string{}}
+	_ = fs.WalkDir(s.fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		name := path.Base(p)
+		ext := path.Ext(name)
+		contentType := mime.TypeByExtension(ext)
+		if ext == ".br" || ext == ".gz" || contentType == "" || strings.HasPrefix(name, ".") {
+			return nil
+		}
+		data, err := fs.ReadFile(s.fsys, p)
+		if err != nil {
+			return err
+		}
+
+		sum := sha256.Sum256(data)
+		f := &file{
+			name:        name,
+			hash:        hex.EncodeToString(sum[:])[:16],
+			contentType: contentType,
+			variants:    map[string][]byte{"": data},
+		}
+		if br, err := fs.ReadFile(s.fsys, p+".br"); err == nil {
+			f.variants["br"] = br
+		}
+		if gz, err := fs.ReadFile(s.fsys, p+".gz"); err == nil {
+			f.variants["gzip"] = gz
+		} else if gz, err := compress(data); err == nil {
+			f.variants["gzip"] = gz
+		}
+
+		hashed := strings.TrimSuffix(name, ext) + "." + f.hash[:10] + ext
+		idx.files[name] = f
+		idx.files[hashed] = f
+		idx.hashed[name] = hashed
+		return nil
+	})
+	return idx
+}
+
+// Helper function to gzip a file that has no precompressed variant
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Helper function to pick the preferred available encoding accepted by the client
+func negotiate(accept string, variants map[string][]byte) string {
+	accepted := map[string]bool{}
+	for _, part := range strings.Split(accept, ",") {
+		coding, params, _ := strings.Cut(part, ";")
+		if _, v, ok := strings.Cut(params, "q="); ok {
+			if q, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil && q == 0 {
+				continue
+			}
+		}
+		accepted[strings.ToLower(strings.TrimSpace(coding))] = true
+	}
+	for _, coding := range []string{"br", "gzip"} {
+		if _, ok := variants[coding]; ok && (accepted[coding] || accepted["*"]) {
+			return coding
+		}
+	}
+	return ""
+}
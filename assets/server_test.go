@@ -0,0 +1,224 @@
+package assets
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+const css = "body{margin:0}"
+
+func newTestServer() *Server {
+	return New(fstest.MapFS{
+		"bulma.min.css":    {Data: []byte(css)},
+		"bulma.min.css.br": {Data: []byte("brotli")},
+		"app.js":           {Data: []byte("console.log(1)")},
+		".gitkeep":         {},
+		"notes.unknown":    {Data: []byte("?")},
+	}, "/static/")
+}
+
+func serve(h http.Handler, method, target string, header http.Header) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, nil)
+	for k, v := range header {
+		r.Header[k] = v
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func mustPath(t *testing.T, s *Server, name string) string {
+	t.Helper()
+	p, err := s.Path(name)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return p
+}
+
+func TestServerPath(t *testing.T) {
+	s := newTestServer()
+
+	got := mustPath(t, s, "bulma.min.css")
+	if !strings.HasPrefix(got, "/static/bulma.min.") || !strings.HasSuffix(got, ".css") || len(got) != len("/static/bulma.min..css")+10 {
+		t.Errorf("expected content-hashed path, got %q", got)
+	}
+	if got2 := mustPath(t, New(fstest.MapFS{"bulma.min.css": {Data: []byte(css)}}, "/static/"), "bulma.min.css"); got2 != got {
+		t.Errorf("expected hash to depend on content only, got %q and %q", got, got2)
+	}
+	if other := mustPath(t, New(fstest.MapFS{"bulma.min.css": {Data: []byte("a{}")}}, "/static/"), "bulma.min.css"); other == got {
+		t.Errorf("expected hash to change with content, got %q", other)
+	}
+	if got, err := s.Path("missing.css"); !errors.Is(err, fs.ErrNotExist) || got != "" {
+		t.Errorf("expected fs.ErrNotExist for missing file, got %q, %v", got, err)
+	}
+}
+
+func TestServerServeHTTP(t *testing.T) {
+	s := newTestServer()
+	hashed := mustPath(t, s, "bulma.min.css")
+
+	tests := []struct {
+		name         string
+		method       string
+		target       string
+		encoding     string
+		status       int
+		cacheControl string
+		contentEnc   string
+		body         string
+	}{
+		{
+			name:         "Hashed name is immutable",
+			target:       hashed,
+			status:       http.StatusOK,
+			cacheControl: "public, max-age=31536000, immutable",
+			body:         css,
+		},
+		{
+			name:         "Plain name is revalidated",
+			target:       "/static/bulma.min.css",
+			status:       http.StatusOK,
+			cacheControl: "no-cache",
+			body:         css,
+		},
+		{
+			name:         "Precompressed brotli variant",
+			target:       hashed,
+			encoding:     "gzip, deflate, br",
+			status:       http.StatusOK,
+			cacheControl: "public, max-age=31536000, immutable",
+			contentEnc:   "br",
+			body:         "brotli",
+		},
+		{
+			name:         "Brotli refused",
+			target:       "/static/bulma.min.css",
+			encoding:     "br;q=0, identity",
+			status:       http.StatusOK,
+			cacheControl: "no-cache",
+			body:         css,
+		},
+		{
+			name:   "Missing file",
+			target: "/static/missing.css",
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "Hidden and unknown files are not served",
+			target: "/static/notes.unknown",
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "Method not allowed",
+			method: http.MethodPost,
+			target: hashed,
+			status: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			header := http.Header{}
+			if tt.encoding != "" {
+				header.Set("Accept-Encoding", tt.encoding)
+			}
+			w := serve(s, method, tt.target, header)
+			if w.Code != tt.status {
+				t.Fatalf("expected status %d, got %d", tt.status, w.Code)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			if got := w.Header().Get("Cache-Control"); got != tt.cacheControl {
+				t.Errorf("expected Cache-Control %q, got %q", tt.cacheControl, got)
+			}
+			if got := w.Header().Get("Content-Encoding"); got != tt.contentEnc {
+				t.Errorf("expected Content-Encoding %q, got %q", tt.contentEnc, got)
+			}
+			if got := w.Header().Get("Content-Type"); got != "text/css; charset=utf-8" {
+				t.Errorf("expected css content type, got %q", got)
+			}
+			if got := w.Header().Get("Vary"); got != "Accept-Encoding" {
+				t.Errorf("expected Vary header, got %q", got)
+			}
+			if got := w.Body.String(); got != tt.body {
+				t.Errorf("expected body %q, got %q", tt.body, got)
+			}
+		})
+	}
+}
+
+func TestServerGzip(t *testing.T) {
+	s := newTestServer()
+	w := serve(s, http.MethodGet, mustPath(t, s, "app.js"), http.Header{"Accept-Encoding": {"gzip"}})
+	if got := w.Header().Get("Content-Encoding"); got != "gzip" {
+		t.Fatalf("expected gzip encoding, got %q", got)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(w.Body.Bytes()))
+	if err != nil {
+		t.Fatalf("invalid gzip body: %v", err)
+	}
+	body, _ := io.ReadAll(zr)
+	if string(body) != "console.log(1)" {
+		t.Errorf("expected decompressed body, got %q", body)
+	}
+}
+
+func TestServerETag(t *testing.T) {
+	s := newTestServer()
+	path := mustPath(t, s, "bulma.min.css")
+
+	w := serve(s, http.MethodGet, path, nil)
+	etag := w.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("expected ETag header")
+	}
+	br := serve(s, http.MethodGet, path, http.Header{"Accept-Encoding": {"br"}}).Header().Get("ETag")
+	if br == etag {
+		t.Errorf("expected encoded variants to have distinct ETags, got %q", br)
+	}
+
+	w = serve(s, http.MethodGet, path, http.Header{"If-None-Match": {etag}})
+	if w.Code != http.StatusNotModified {
+		t.Errorf("expected status %d, got %d", http.StatusNotModified, w.Code)
+	}
+
+	w = serve(s, http.MethodHead, path, nil)
+	if w.Code != http.StatusOK || w.Body.Len() != 0 {
+		t.Errorf("expected empty HEAD response, got %d with %d bytes", w.Code, w.Body.Len())
+	}
+}
+
+func TestDefault(t *testing.T) {
+	got, err := Path(Bulma)
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Skip("Bulma is not embedded; run go generate ./assets")
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !regexp.MustCompile(`^` + Prefix + `bulma\.min\.[0-9a-f]{10}\.css$`).MatchString(got) {
+		t.Errorf("expected content-hashed Bulma path under %s, got %q", Prefix, got)
+	}
+	w := serve(Handler(), http.MethodGet, got, nil)
+	if w.Code != http.StatusOK || !strings.HasPrefix(w.Header().Get("Content-Type"), "text/css") {
+		t.Errorf("expected 200 text/css, got %d %q", w.Code, w.Header().Get("Content-Type"))
+	}
+	if Handler() != Default {
+		t.Error("expected Handler to return Default")
+	}
+}
@@ -0,0 +1,160 @@
+package templaui
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/a-h/templ"
+
+	"github.com/alexferl/templaui/assets"
+)
+
+// CDN identifies a content delivery network serving Bulma.
+type CDN string
+
+const (
+	CDNJS    CDN = "cdnjs"    // cdnjs.cloudflare.com (default)
+	JSDelivr CDN = "jsdelivr" // cdn.jsdelivr.net
+	Unpkg    CDN = "unpkg"    // unpkg.com
+)
+
+// BulmaVersion is the Bulma version loaded when BulmaConfig.Version is empty.
+const BulmaVersion = "1.0.4"
+
+// ErrUnknownBulmaVersion is returned for Bulma versions missing from the registry.
+var ErrUnknownBulmaVersion = errors.New("templaui: unknown Bulma version")
+
+// ErrUnknownCDN is returned for CDNs other than CDNJS, JSDelivr and Unpkg.
+var ErrUnknownCDN = errors.New("templaui: unknown CDN")
+
+var (
+	bulmaMu sync.RWMutex
+
+	// SRI hashes of css/bulma.min.css by version. The CDNs all serve the
+	// file published to npm, so a version has the same hash on each.
+	bulmaIntegrity = map[string]string{
+		"1.0.4": "sha512-yh2RE0wZCVZeysGiqTwDTO/dKelCbS9bP2L94UvOFtl/FKXcNAje3Y2oBg/ZMZ3LS1sicYk4dYVGtDex75fvvA==",
+	}
+)
+
+// cdnOrigins maps each CDN to its origin, used for preconnect hints.
+var cdnOrigins = map[CDN]string{
+	CDNJS:    "https://cdnjs.cloudflare.com",
+	JSDelivr: "https://cdn.jsdelivr.net",
+	Unpkg:    "https://unpkg.com",
+}
+
+// RegisterBulmaVersion adds a Bulma version and the SRI hash of its
+// css/bulma.min.css to the registry, so BulmaCSS can load it from any
+// CDN. Registering a known version replaces its hash, which is
+// "sha512-" followed by the output of:
+//
+//	openssl dgst -sha512 -binary bulma.min.css | openssl base64 -A
+func RegisterBulmaVersion(version, integrity string) {
+	bulmaMu.Lock()
+	defer bulmaMu.Unlock()
+	bulmaIntegrity[version] = integrity
+}
+
+// BulmaVersions returns the registered Bulma versions from oldest to
+// newest.
+func BulmaVersions() []string {
+	bulmaMu.RLock()
+	defer bulmaMu.RUnlock()
+	return slices.SortedFunc(maps.Keys(bulmaIntegrity), compareVersions)
+}
+
+// BulmaIntegrity returns the SRI hash of a registered Bulma version.
+func BulmaIntegrity(version string) (string, error) {
+	bulmaMu.RLock()
+	defer bulmaMu.RUnlock()
+	integrity, ok := bulmaIntegrity[version]
+	if !ok {
+		return "", fmt.Errorf("%w %q (known: %v)", ErrUnknownBulmaVersion, version, slices.SortedFunc(maps.Keys(bulmaIntegrity), compareVersions))
+	}
+	return integrity, nil
+}
+
+// BulmaURL returns the URL of css/bulma.min.css for a version on a CDN.
+// An empty CDN selects CDNJS.
+func BulmaURL(cdn CDN, version string) (string, error) {
+	switch cdn {
+	case "", CDNJS:
+		return "https://cdnjs.cloudflare.com/ajax/libs/bulma/" + version + "/css/bulma.min.css", nil
+	case JSDelivr:
+		return "https://cdn.jsdelivr.net/npm/bulma@" + version + "/css/bulma.min.css", nil
+	case Unpkg:
+		return "https://unpkg.com/bulma@" + version + "/css/bulma.min.css", nil
+	}
+	return "", fmt.Errorf("%w %q", ErrUnknownCDN, cdn)
+}
+
+// Helper function to render the CDN stylesheet link, failing for unknown versions
+func bulmaCDNLink(config BulmaConfig) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		href, err := BulmaURL(config.CDN, config.Version)
+		if err != nil {
+			return err
+		}
+		integrity := config.Integrity
+		if integrity == "" {
+			if integrity, err = BulmaIntegrity(config.Version); err != nil {
+				return err
+			}
+		}
+		return Link(LinkProps{
+			Rel:            "stylesheet",
+			Href:           href,
+			Integrity:      integrity,
+			CrossOrigin:    "anonymous",
+			ReferrerPolicy: "no-referrer",
+		}).Render(ctx, w)
+	})
+}
+
+// Helper function to render the embedded stylesheet link, failing when Bulma isn't embedded
+func bulmaEmbeddedLink() templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		href, err := assets.Path(assets.Bulma)
+		if err != nil {
+			return err
+		}
+		return Link(LinkProps{Rel: "stylesheet", Href: href}).Render(ctx, w)
+	})
+}
+
+// Helper function to return the origin of a CDN
+func cdnOrigin(cdn CDN) string {
+	if origin, ok := cdnOrigins[cdn]; ok {
+		return origin
+	}
+	return cdnOrigins[CDNJS]
+}
+
+// Helper function to compare dotted version numbers part by part, numerically
+// when both parts are numbers
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := range min(len(as), len(bs)) {
+		x, errX := strconv.Atoi(as[i])
+		y, errY := strconv.Atoi(bs[i])
+		if errX == nil && errY == nil {
+			if c := cmp.Compare(x, y); c != 0 {
+				return c
+			}
+			continue
+		}
+		if c := strings.Compare(as[i], bs[i]); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(as), len(bs))
+}
@@ -0,0 +1,113 @@
+package templaui
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestBulmaURL(t *testing.T) {
+	tests := []struct {
+		cdn    CDN
+		expect string
+	}{
+		{cdn: "", expect: "https://cdnjs.cloudflare.com/ajax/libs/bulma/1.0.4/css/bulma.min.css"},
+		{cdn: CDNJS, expect: "https://cdnjs.cloudflare.com/ajax/libs/bulma/1.0.4/css/bulma.min.css"},
+		{cdn: JSDelivr, expect: "https://cdn.jsdelivr.net/npm/bulma@1.0.4/css/bulma.min.css"},
+		{cdn: Unpkg, expect: "https://unpkg.com/bulma@1.0.4/css/bulma.min.css"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.cdn), func(t *testing.T) {
+			got, err := BulmaURL(tt.cdn, "1.0.4")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expect {
+				t.Errorf("expected %q, got %q", tt.expect, got)
+			}
+		})
+	}
+
+	if _, err := BulmaURL("example", "1.0.4"); !errors.Is(err, ErrUnknownCDN) {
+		t.Errorf("expected ErrUnknownCDN, got %v", err)
+	}
+}
+
+func TestBulmaIntegrity(t *testing.T) {
+	got, err := BulmaIntegrity(BulmaVersion)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "sha512-yh2RE0wZCVZeysGiqTwDTO/dKelCbS9bP2L94UvOFtl/FKXcNAje3Y2oBg/ZMZ3LS1sicYk4dYVGtDex75fvvA==" {
+		t.Errorf("unexpected integrity for %s: %q", BulmaVersion, got)
+	}
+
+	if _, err := BulmaIntegrity("0.0.1"); !errors.Is(err, ErrUnknownBulmaVersion) {
+		t.Errorf("expected ErrUnknownBulmaVersion, got %v", err)
+	}
+}
+
+func TestRegisterBulmaVersion(t *testing.T) {
+	t.Cleanup(func() {
+		bulmaMu.Lock()
+		delete(bulmaIntegrity, "9.9.9")
+		bulmaMu.Unlock()
+	})
+
+	RegisterBulmaVersion("9.9.9", "sha384-test")
+	got, err := BulmaIntegrity("9.9.9")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "sha384-test" {
+		t.Errorf("expected registered integrity, got %q", got)
+	}
+	if !slices.Contains(BulmaVersions(), "9.9.9") {
+		t.Errorf("expected registered version in %v", BulmaVersions())
+	}
+}
+
+func TestBulmaVersions(t *testing.T) {
+	versions := BulmaVersions()
+	if !slices.Contains(versions, BulmaVersion) {
+		t.Errorf("expected default version %s in %v", BulmaVersion, versions)
+	}
+	if !slices.IsSortedFunc(versions, compareVersions) {
+		t.Errorf("expected sorted versions, got %v", versions)
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	versions := []string{"1.0.10", "0.9.4", "1.0.2", "1.0", "1.1.0"}
+	slices.SortFunc(versions, compareVersions)
+	expect := []string{"0.9.4", "1.0", "1.0.2", "1.0.10", "1.1.0"}
+	if !slices.Equal(versions, expect) {
+		t.Errorf("expected %v, got %v", expect, versions)
+	}
+}
+
+func TestBulmaCSSVersionOnEachCDN(t *testing.T) {
+	t.Cleanup(func() {
+		bulmaMu.Lock()
+		delete(bulmaIntegrity, "1.0.2")
+		bulmaMu.Unlock()
+	})
+	RegisterBulmaVersion("1.0.2", "sha512-test")
+
+	for _, cdn := range []CDN{CDNJS, JSDelivr, Unpkg} {
+		t.Run(string(cdn), func(t *testing.T) {
+			var buf strings.Builder
+			if err := BulmaCSS(BulmaConfig{Version: "1.0.2", CDN: cdn}).Render(context.Background(), &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			href, _ := BulmaURL(cdn, "1.0.2")
+			expect := `<link rel="stylesheet" href="` + href + `" integrity="sha512-test" crossorigin="anonymous" referrerpolicy="no-referrer">`
+			if got := strings.TrimSpace(buf.String()); got != expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", expect, got)
+			}
+		})
+	}
+}
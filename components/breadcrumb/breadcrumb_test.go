@@ -59,3 +59,124 @@ func TestBreadcrumb(t *testing.T) {
 		})
 	}
 }
+
+func TestBreadcrumbItem(t *testing.T) {
+	tests := []struct {
+		name   string
+		props  BreadcrumbItemProps
+		expect string
+	}{
+		{
+			name:   "Default",
+			props:  BreadcrumbItemProps{},
+			expect: `<li><a></a></li>`,
+		},
+		{
+			name:   "With href",
+			props:  BreadcrumbItemProps{Href: "/docs"},
+			expect: `<li><a href="/docs"></a></li>`,
+		},
+		{
+			name:   "Active item",
+			props:  BreadcrumbItemProps{Href: "/docs/intro", IsActive: true},
+			expect: `<li class="is-active"><a href="/docs/intro" aria-current="page"></a></li>`,
+		},
+		{
+			name: "All fields combined",
+			props: BreadcrumbItemProps{
+				ID:         "crumb-docs",
+				Class:      []string{"foo"},
+				Href:       "/docs",
+				IsActive:   true,
+				Attributes: templ.Attributes{"data-role": "crumb"},
+			},
+			expect: `<li id="crumb-docs" class="is-active foo" data-role="crumb"><a href="/docs" aria-current="page"></a></li>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := BreadcrumbItem(tt.props).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestCrumbs(t *testing.T) {
+	tests := []struct {
+		name   string
+		crumbs []Crumb
+		props  []BreadcrumbProps
+		expect string
+	}{
+		{
+			name:   "Empty",
+			crumbs: nil,
+			expect: `<nav class="breadcrumb" aria-label="breadcrumbs"><ul></ul></nav>`,
+		},
+		{
+			name: "Last crumb is active",
+			crumbs: []Crumb{
+				{Label: "Home", Href: "/"},
+				{Label: "Docs", Href: "/docs"},
+			},
+			expect: `<nav class="breadcrumb" aria-label="breadcrumbs"><ul>` +
+				`<li><a href="/">Home</a></li>` +
+				`<li class="is-active"><a href="/docs" aria-current="page">Docs</a></li>` +
+				`</ul></nav>`,
+		},
+		{
+			name: "With icon",
+			crumbs: []Crumb{
+				{Label: "Home", Href: "/", Icon: templ.Raw(`<span class="icon is-small"><i class="fas fa-home"></i></span>`)},
+			},
+			expect: `<nav class="breadcrumb" aria-label="breadcrumbs"><ul>` +
+				`<li class="is-active"><a href="/" aria-current="page"><span class="icon is-small"><i class="fas fa-home"></i></span> <span>Home</span></a></li>` +
+				`</ul></nav>`,
+		},
+		{
+			name:   "With props and custom aria-label",
+			crumbs: []Crumb{{Label: "Home", Href: "/"}},
+			props: []BreadcrumbProps{{
+				Style:      HasArrowSeparator,
+				Attributes: templ.Attributes{"aria-label": "You are here"},
+			}},
+			expect: `<nav class="breadcrumb has-arrow-separator" aria-label="You are here"><ul>` +
+				`<li class="is-active"><a href="/" aria-current="page">Home</a></li>` +
+				`</ul></nav>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := Crumbs(tt.crumbs, tt.props...).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestCrumbsDoesNotMutateAttributes(t *testing.T) {
+	attrs := templ.Attributes{"data-role": "navi"}
+	var buf strings.Builder
+	err := Crumbs(nil, BreadcrumbProps{Attributes: attrs}).Render(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if _, ok := attrs["aria-label"]; ok {
+		t.Errorf("expected caller attributes to be left untouched, got %v", attrs)
+	}
+}
@@ -0,0 +1,70 @@
+package breadcrumb
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/a-h/templ"
+)
+
+// Crumb describes a single breadcrumb entry.
+type Crumb struct {
+	// Text displayed for the breadcrumb
+	Label string
+
+	// URL the breadcrumb links to
+	Href string
+
+	// Optional icon rendered before the label
+	Icon templ.Component
+}
+
+// CrumbsFromPath derives breadcrumbs from a URL path.
+//
+// The first crumb always points at the root ("/"), followed by one crumb
+// per path segment linking to the path up to and including that segment.
+// The label func receives the unescaped segment (empty for the root) and
+// the crumb's href, and returns the text to display; returning an empty
+// label skips that crumb. A nil label func uses "Home" for the root and
+// a humanized version of each segment otherwise.
+//
+// For example, "/admin/user-groups" yields Home (/), Admin (/admin) and
+// User groups (/admin/user-groups).
+func CrumbsFromPath(path string, label func(segment, href string) string) []Crumb {
+	if label == nil {
+		label = defaultLabel
+	}
+
+	var crumbs []Crumb
+	if l := label("", "/"); l != "" {
+		crumbs = append(crumbs, Crumb{Label: l, Href: "/"})
+	}
+
+	href := ""
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" {
+			continue
+		}
+		href += "/" + segment
+		name, err := url.PathUnescape(segment)
+		if err != nil {
+			name = segment
+		}
+		if l := label(name, href); l != "" {
+			crumbs = append(crumbs, Crumb{Label: l, Href: href})
+		}
+	}
+	return crumbs
+}
+
+// Helper function to turn a path segment into a readable label
+func defaultLabel(segment, _ string) string {
+	if segment == "" {
+		return "Home"
+	}
+	segment = strings.NewReplacer("-", " ", "_", " ").Replace(segment)
+	r, size := utf8.DecodeRuneInString(segment)
+	return string(unicode.ToUpper(r)) + segment[size:]
+}
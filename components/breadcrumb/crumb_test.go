@@ -0,0 +1,95 @@
+package breadcrumb
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCrumbsFromPath(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		label  func(segment, href string) string
+		expect []Crumb
+	}{
+		{
+			name:   "Root",
+			path:   "/",
+			expect: []Crumb{{Label: "Home", Href: "/"}},
+		},
+		{
+			name: "Nested path with default labels",
+			path: "/admin/user-groups/new_member",
+			expect: []Crumb{
+				{Label: "Home", Href: "/"},
+				{Label: "Admin", Href: "/admin"},
+				{Label: "User groups", Href: "/admin/user-groups"},
+				{Label: "New member", Href: "/admin/user-groups/new_member"},
+			},
+		},
+		{
+			name: "Trailing and duplicate slashes are ignored",
+			path: "/docs//intro/",
+			expect: []Crumb{
+				{Label: "Home", Href: "/"},
+				{Label: "Docs", Href: "/docs"},
+				{Label: "Intro", Href: "/docs/intro"},
+			},
+		},
+		{
+			name: "Escaped segments are unescaped for labels only",
+			path: "/files/%C3%A9t%C3%A9%20report",
+			expect: []Crumb{
+				{Label: "Home", Href: "/"},
+				{Label: "Files", Href: "/files"},
+				{Label: "Été report", Href: "/files/%C3%A9t%C3%A9%20report"},
+			},
+		},
+		{
+			name: "Custom label func",
+			path: "/users/42",
+			label: func(segment, href string) string {
+				switch {
+				case segment == "":
+					return "Dashboard"
+				case href == "/users/42":
+					return "Alice"
+				default:
+					return strings.ToUpper(segment)
+				}
+			},
+			expect: []Crumb{
+				{Label: "Dashboard", Href: "/"},
+				{Label: "USERS", Href: "/users"},
+				{Label: "Alice", Href: "/users/42"},
+			},
+		},
+		{
+			name: "Empty label skips crumb",
+			path: "/app/settings",
+			label: func(segment, _ string) string {
+				if segment == "" || segment == "app" {
+					return ""
+				}
+				return segment
+			},
+			expect: []Crumb{
+				{Label: "settings", Href: "/app/settings"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CrumbsFromPath(tt.path, tt.label)
+			if len(got) != len(tt.expect) {
+				t.Fatalf("expected %d crumbs, got %d: %+v", len(tt.expect), len(got), got)
+			}
+			for i, exp := range tt.expect {
+				if got[i].Label != exp.Label || got[i].Href != exp.Href {
+					t.Errorf("at index %d: expected %+v, got %+v", i, exp, got[i])
+				}
+			}
+		})
+	}
+}
@@ -140,3 +140,151 @@ func TestMenuList(t *testing.T) {
 		})
 	}
 }
+
+func TestMenuLink(t *testing.T) {
+	tests := []struct {
+		name   string
+		props  MenuLinkProps
+		expect string
+	}{
+		{
+			name:   "Default",
+			props:  MenuLinkProps{},
+			expect: `<a></a>`,
+		},
+		{
+			name:   "With href",
+			props:  MenuLinkProps{Href: "/dashboard"},
+			expect: `<a href="/dashboard"></a>`,
+		},
+		{
+			name:   "Active link",
+			props:  MenuLinkProps{Href: "/dashboard", IsActive: true},
+			expect: `<a href="/dashboard" class="is-active" aria-current="page"></a>`,
+		},
+		{
+			name: "All fields combined",
+			props: MenuLinkProps{
+				ID:         "link1",
+				Class:      []string{"foo"},
+				Href:       "/team",
+				IsActive:   true,
+				Attributes: templ.Attributes{"data-role": "link"},
+			},
+			expect: `<a id="link1" href="/team" class="is-active foo" aria-current="page" data-role="link"></a>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := MenuLink(tt.props).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestTree(t *testing.T) {
+	sections := []MenuSection{
+		{
+			Label: "General",
+			Items: []MenuItem{
+				{Label: "Dashboard", Href: "/"},
+				{Label: "Customers", Href: "/customers"},
+			},
+		},
+		{
+			Label: "Administration",
+			Items: []MenuItem{
+				{
+					Label: "Team",
+					Href:  "/team",
+					Children: []MenuItem{
+						{Label: "Members", Href: "/team/members"},
+						{Label: "Invites", Href: "/team/invites", IsExact: true},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		props  TreeProps
+		expect string
+	}{
+		{
+			name:  "No active item",
+			props: TreeProps{Sections: sections[:1], CurrentPath: "/settings"},
+			expect: `<aside class="menu"><p class="menu-label">General</p> <ul class="menu-list">` +
+				`<li><a href="/">Dashboard</a></li><li><a href="/customers">Customers</a></li>` +
+				`</ul></aside>`,
+		},
+		{
+			name:  "Root only matches exactly",
+			props: TreeProps{Sections: sections[:1], CurrentPath: "/"},
+			expect: `<aside class="menu"><p class="menu-label">General</p> <ul class="menu-list">` +
+				`<li class="is-active"><a href="/" class="is-active" aria-current="page">Dashboard</a></li><li><a href="/customers">Customers</a></li>` +
+				`</ul></aside>`,
+		},
+		{
+			name:  "Prefix match",
+			props: TreeProps{Sections: sections[:1], CurrentPath: "/customers/42"},
+			expect: `<aside class="menu"><p class="menu-label">General</p> <ul class="menu-list">` +
+				`<li><a href="/">Dashboard</a></li><li class="is-active"><a href="/customers" class="is-active" aria-current="page">Customers</a></li>` +
+				`</ul></aside>`,
+		},
+		{
+			name:  "Nested item marks ancestors",
+			props: TreeProps{Sections: sections[1:], CurrentPath: "/team/members/7"},
+			expect: `<aside class="menu"><p class="menu-label">Administration</p> <ul class="menu-list">` +
+				`<li class="is-active"><a href="/team">Team</a><ul>` +
+				`<li class="is-active"><a href="/team/members" class="is-active" aria-current="page">Members</a></li>` +
+				`<li><a href="/team/invites">Invites</a></li>` +
+				`</ul></li></ul></aside>`,
+		},
+		{
+			name:  "Exact item falls back to parent prefix match",
+			props: TreeProps{Sections: sections[1:], CurrentPath: "/team/invites/3"},
+			expect: `<aside class="menu"><p class="menu-label">Administration</p> <ul class="menu-list">` +
+				`<li class="is-active"><a href="/team" class="is-active" aria-current="page">Team</a><ul>` +
+				`<li><a href="/team/members">Members</a></li>` +
+				`<li><a href="/team/invites">Invites</a></li>` +
+				`</ul></li></ul></aside>`,
+		},
+		{
+			name: "Unlabelled section with icon and props",
+			props: TreeProps{
+				ID:          "sidebar",
+				Size:        IsSmall,
+				CurrentPath: "/",
+				Sections: []MenuSection{{Items: []MenuItem{
+					{Label: "Home", Href: "/", Icon: templ.Raw(`<span class="icon"><i class="fas fa-home"></i></span>`)},
+				}}},
+			},
+			expect: `<aside id="sidebar" class="menu is-small"> <ul class="menu-list">` +
+				`<li class="is-active"><a href="/" class="is-active" aria-current="page"><span class="icon"><i class="fas fa-home"></i></span> <span>Home</span></a></li>` +
+				`</ul></aside>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := Tree(tt.props).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
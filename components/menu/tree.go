@@ -0,0 +1,46 @@
+package menu
+
+import (
+	"github.com/a-h/templ"
+
+	"github.com/alexferl/templaui/internal/route"
+)
+
+// MenuItem describes a navigation entry in a menu tree.
+type MenuItem struct {
+	// Text displayed for the menu item
+	Label string
+
+	// URL the menu item links to
+	Href string
+
+	// Optional icon rendered before the label
+	Icon templ.Component
+
+	// Nested menu items rendered as a sub-list
+	Children []MenuItem
+
+	// Only match the exact path (disables prefix matching for this item)
+	IsExact bool
+}
+
+// MenuSection groups menu items under an optional menu label.
+type MenuSection struct {
+	// Optional section header rendered as a menu label
+	Label string
+
+	// Menu items of the section
+	Items []MenuItem
+}
+
+// activeTrail returns the most specific item matching path and all of its
+// ancestors. Items are identified by their address in the section slices.
+func activeTrail(sections []MenuSection, path string) map[*MenuItem]bool {
+	groups := make([][]MenuItem, len(sections))
+	for i, section := range sections {
+		groups[i] = section.Items
+	}
+	return route.Trail(groups, path, func(item *MenuItem) (string, bool, []MenuItem) {
+		return item.Href, item.IsExact, item.Children
+	})
+}
@@ -29,6 +29,11 @@ func TestModal(t *testing.T) {
 			props:  ModalProps{Size: IsLarge, IsActive: true},
 			expect: `<div class="modal is-large is-active"></div>`,
 		},
+		{
+			name:   "As labelled dialog",
+			props:  ModalProps{IsDialog: true, LabelledBy: "modal-title"},
+			expect: `<div class="modal" role="dialog" aria-modal="true" aria-labelledby="modal-title"></div>`,
+		},
 		{
 			name: "All fields combined",
 			props: ModalProps{
@@ -335,3 +340,181 @@ func TestModalCardFoot(t *testing.T) {
 		})
 	}
 }
+
+func TestDialog(t *testing.T) {
+	tests := []struct {
+		name   string
+		props  DialogProps
+		expect string
+	}{
+		{
+			name:   "Default",
+			props:  DialogProps{},
+			expect: `<dialog class="modal-dialog"><div class="modal-card"><p>Content</p></div></dialog>`,
+		},
+		{
+			name:   "With ID, label and custom classes",
+			props:  DialogProps{ID: "confirm", LabelledBy: "confirm-title", Class: []string{"custom-dialog"}},
+			expect: `<dialog id="confirm" class="modal-dialog custom-dialog" aria-labelledby="confirm-title"><div class="modal-card"><p>Content</p></div></dialog>`,
+		},
+		{
+			name:   "Open with attributes",
+			props:  DialogProps{IsOpen: true, Attributes: templ.Attributes{"data-test": "value"}},
+			expect: `<dialog class="modal-dialog" open data-test="value"><div class="modal-card"><p>Content</p></div></dialog>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			ctx := templ.InitializeContext(context.Background())
+			// Render the shared stylesheet first so only the dialog is compared
+			if err := dialogStyle().Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			buf.Reset()
+			ctx = templ.WithChildren(ctx, templ.Raw("<p>Content</p>"))
+			err := Dialog(tt.props).Render(ctx, &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+
+	t.Run("Stylesheet rendered once with nonce", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.WithNonce(templ.InitializeContext(context.Background()), "r4nd0m")
+		for range 2 {
+			if err := Dialog().Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+		}
+		got := buf.String()
+		if !strings.HasPrefix(got, `<style nonce="r4nd0m">`) {
+			t.Errorf("expected stylesheet with nonce, got: %s", got)
+		}
+		if n := strings.Count(got, "<style"); n != 1 {
+			t.Errorf("expected stylesheet to be rendered once, got %d", n)
+		}
+	})
+}
+
+func TestDialogForm(t *testing.T) {
+	tests := []struct {
+		name   string
+		props  DialogFormProps
+		expect string
+	}{
+		{
+			name:   "Default",
+			props:  DialogFormProps{},
+			expect: `<form method="dialog"></form>`,
+		},
+		{
+			name:   "With ID and custom classes",
+			props:  DialogFormProps{ID: "actions", Class: []string{"buttons"}},
+			expect: `<form id="actions" method="dialog" class="buttons"></form>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := DialogForm(tt.props).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestDialogClose(t *testing.T) {
+	tests := []struct {
+		name   string
+		props  DialogCloseProps
+		expect string
+	}{
+		{
+			name:   "Default",
+			props:  DialogCloseProps{},
+			expect: `<form method="dialog"><button class="delete" aria-label="close"></button></form>`,
+		},
+		{
+			name: "All fields combined",
+			props: DialogCloseProps{
+				ID:         "close1",
+				Class:      []string{"is-large"},
+				Label:      "Dismiss",
+				Value:      "cancel",
+				Attributes: templ.Attributes{"data-test": "value"},
+			},
+			expect: `<form method="dialog"><button id="close1" class="delete is-large" aria-label="Dismiss" data-test="value" value="cancel"></button></form>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := DialogClose(tt.props).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestModalScript(t *testing.T) {
+	t.Run("Without nonce", func(t *testing.T) {
+		var buf strings.Builder
+		err := ModalScript().Render(context.Background(), &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		got := buf.String()
+		if !strings.HasPrefix(got, "<script>") {
+			t.Errorf("expected script without nonce, got: %s", got)
+		}
+		for _, want := range []string{"[data-modal-target]", `"Escape"`, `"aria-modal"`} {
+			if !strings.Contains(got, want) {
+				t.Errorf("expected script to contain %s, got: %s", want, got)
+			}
+		}
+	})
+
+	t.Run("With nonce", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.WithNonce(context.Background(), "r4nd0m")
+		err := ModalScript().Render(ctx, &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		if !strings.HasPrefix(buf.String(), `<script nonce="r4nd0m">`) {
+			t.Errorf("expected script with nonce, got: %s", buf.String())
+		}
+	})
+
+	t.Run("Rendered once per context", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.InitializeContext(context.Background())
+		for range 2 {
+			if err := ModalScript().Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+		}
+		if n := strings.Count(buf.String(), "<script"); n != 1 {
+			t.Errorf("expected script to be rendered once, got %d", n)
+		}
+	})
+}
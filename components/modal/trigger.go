@@ -0,0 +1,27 @@
+package modal
+
+import "github.com/a-h/templ"
+
+// TargetAttr is the attribute naming the modal a trigger element opens.
+const TargetAttr = "data-modal-target"
+
+// Target returns the attributes that turn any element into a trigger
+// opening the modal with the given ID when ModalScript is on the page,
+// e.g. button.ButtonProps{Attributes: modal.Target("confirm")}.
+func Target(id string) templ.Attributes {
+	return templ.Attributes{
+		TargetAttr:      id,
+		"aria-haspopup": "dialog",
+		"aria-controls": id,
+	}
+}
+
+// DialogTarget returns the attributes that turn a button into a trigger
+// opening the Dialog with the given ID as a modal. Browsers supporting
+// invoker commands open it natively; elsewhere ModalScript handles it.
+func DialogTarget(id string) templ.Attributes {
+	attrs := Target(id)
+	attrs["command"] = "show-modal"
+	attrs["commandfor"] = id
+	return attrs
+}
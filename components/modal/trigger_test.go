@@ -0,0 +1,34 @@
+package modal
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestTarget(t *testing.T) {
+	got := Target("confirm")
+	expect := templ.Attributes{
+		"data-modal-target": "confirm",
+		"aria-haspopup":     "dialog",
+		"aria-controls":     "confirm",
+	}
+	if !maps.Equal(got, expect) {
+		t.Errorf("expected %v, got %v", expect, got)
+	}
+}
+
+func TestDialogTarget(t *testing.T) {
+	got := DialogTarget("confirm")
+	expect := templ.Attributes{
+		"data-modal-target": "confirm",
+		"aria-haspopup":     "dialog",
+		"aria-controls":     "confirm",
+		"command":           "show-modal",
+		"commandfor":        "confirm",
+	}
+	if !maps.Equal(got, expect) {
+		t.Errorf("expected %v, got %v", expect, got)
+	}
+}
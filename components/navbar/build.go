@@ -0,0 +1,63 @@
+package navbar
+
+import (
+	"github.com/a-h/templ"
+
+	"github.com/alexferl/templaui/internal/route"
+)
+
+// NavItem describes an entry of a navbar built with Build.
+type NavItem struct {
+	// Text displayed for the item
+	Label string
+
+	// URL the item links to (optional for dropdown triggers)
+	Href string
+
+	// Optional icon rendered before the label
+	Icon templ.Component
+
+	// Custom content (e.g. buttons) rendered in a plain navbar item instead of a link
+	Content templ.Component
+
+	// Items rendered in a dropdown below this item (one level deep)
+	Children []NavItem
+
+	// Only match the exact path (disables prefix matching for this item)
+	IsExact bool
+
+	// Render a divider instead of an item (inside dropdowns)
+	IsDivider bool
+}
+
+// NavConfig describes a complete navbar rendered by Build.
+type NavConfig struct {
+	// Props applied to the navbar container
+	Navbar NavbarProps
+
+	// Content of the brand section, e.g. a logo link
+	Brand templ.Component
+
+	// Items rendered in the left part of the menu
+	Start []NavItem
+
+	// Items rendered in the right part of the menu
+	End []NavItem
+
+	// Current request path used to mark the active item
+	CurrentPath string
+
+	// HTML id of the collapsible menu (defaults to "navbar-menu")
+	MenuID string
+}
+
+// activeTrail returns the most specific item matching path and its parent
+// dropdown, if any. Items are identified by their address in the slices.
+func activeTrail(groups [][]NavItem, path string) map[*NavItem]bool {
+	return route.Trail(groups, path, func(item *NavItem) (string, bool, []NavItem) {
+		if item.IsDivider || item.Content != nil {
+			return "", false, nil
+		}
+		return item.Href, item.IsExact, item.Children
+	})
+}
@@ -146,6 +146,11 @@ func TestNavbarBurger(t *testing.T) {
 			props:  NavbarBurgerProps{ID: "burger1", Class: []string{"custom-burger"}},
 			expect: `<a id="burger1" class="navbar-burger custom-burger" role="button" aria-label="menu" aria-expanded="false" aria-controls="navbar-menu"></a>`,
 		},
+		{
+			name:   "With controlled menu",
+			props:  NavbarBurgerProps{ID: "main-burger", Controls: "main-menu"},
+			expect: `<a id="main-burger" class="navbar-burger" role="button" aria-label="menu" aria-expanded="false" aria-controls="main-menu"></a>`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -184,6 +189,11 @@ func TestNavbarMenu(t *testing.T) {
 			props:  NavbarMenuProps{IsActive: true},
 			expect: `<div id="navbar-menu" class="navbar-menu is-active" aria-labelledby="navbar-burger"></div>`,
 		},
+		{
+			name:   "With labelling burger",
+			props:  NavbarMenuProps{ID: "main-menu", LabelledBy: "main-burger"},
+			expect: `<div id="main-menu" class="navbar-menu" aria-labelledby="main-burger"></div>`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -322,6 +332,55 @@ func TestNavbarItem(t *testing.T) {
 	}
 }
 
+func TestNavbarItemLink(t *testing.T) {
+	tests := []struct {
+		name   string
+		props  NavbarItemLinkProps
+		expect string
+	}{
+		{
+			name:   "Default",
+			props:  NavbarItemLinkProps{},
+			expect: `<a class="navbar-item"></a>`,
+		},
+		{
+			name:   "With href",
+			props:  NavbarItemLinkProps{Href: "/about"},
+			expect: `<a href="/about" class="navbar-item"></a>`,
+		},
+		{
+			name:   "With active state",
+			props:  NavbarItemLinkProps{Href: "/about", IsActive: true},
+			expect: `<a href="/about" class="navbar-item is-active" aria-current="page"></a>`,
+		},
+		{
+			name: "All fields combined",
+			props: NavbarItemLinkProps{
+				ID:         "item1",
+				Class:      []string{"custom-item"},
+				Href:       "/about",
+				IsTab:      true,
+				Attributes: templ.Attributes{"data-test": "value"},
+			},
+			expect: `<a id="item1" href="/about" class="navbar-item is-tab custom-item" data-test="value"></a>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := NavbarItemLink(tt.props).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
+
 func TestNavbarLink(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -343,6 +402,11 @@ func TestNavbarLink(t *testing.T) {
 			props:  NavbarLinkProps{IsArrowless: true},
 			expect: `<a class="navbar-link is-arrowless"></a>`,
 		},
+		{
+			name:   "With href",
+			props:  NavbarLinkProps{Href: "/docs"},
+			expect: `<a href="/docs" class="navbar-link"></a>`,
+		},
 		{
 			name: "All fields combined",
 			props: NavbarLinkProps{
@@ -450,3 +514,118 @@ func TestNavbarDivider(t *testing.T) {
 		})
 	}
 }
+
+func TestBuild(t *testing.T) {
+	docs := []NavItem{
+		{Label: "Home", Href: "/", IsExact: true},
+		{Label: "Docs", Href: "/docs", Children: []NavItem{
+			{Label: "Forms", Href: "/docs/forms"},
+			{IsDivider: true},
+			{Label: "Layout", Href: "/docs/layout"},
+		}},
+	}
+
+	tests := []struct {
+		name   string
+		config NavConfig
+		expect string
+	}{
+		{
+			name:   "Empty",
+			config: NavConfig{},
+			expect: `<nav aria-label="main navigation" class="navbar" role="navigation"><div class="navbar-brand"> <a id="navbar-menu-burger" class="navbar-burger" role="button" aria-label="menu" aria-expanded="false" aria-controls="navbar-menu" tabindex="0"><span aria-hidden="true"></span> <span aria-hidden="true"></span> <span aria-hidden="true"></span> <span aria-hidden="true"></span></a></div> <div id="navbar-menu" class="navbar-menu" aria-labelledby="navbar-menu-burger"> </div></nav>`,
+		},
+		{
+			name: "Brand, dropdown and active child",
+			config: NavConfig{
+				Brand:       templ.Raw(`<a class="navbar-item" href="/">Logo</a>`),
+				Start:       docs,
+				End:         []NavItem{{Content: templ.Raw(`<a class="button">Log in</a>`)}},
+				CurrentPath: "/docs/forms",
+			},
+			expect: `<nav aria-label="main navigation" class="navbar" role="navigation"><div class="navbar-brand"><a class="navbar-item" href="/">Logo</a> <a id="navbar-menu-burger" class="navbar-burger" role="button" aria-label="menu" aria-expanded="false" aria-controls="navbar-menu" tabindex="0"><span aria-hidden="true"></span> <span aria-hidden="true"></span> <span aria-hidden="true"></span> <span aria-hidden="true"></span></a></div> <div id="navbar-menu" class="navbar-menu" aria-labelledby="navbar-menu-burger"><div class="navbar-start"><a href="/" class="navbar-item">Home</a><div class="navbar-item is-hoverable has-dropdown"><a href="/docs" class="navbar-link is-active">Docs</a> <div class="navbar-dropdown"><a href="/docs/forms" class="navbar-item is-active" aria-current="page">Forms</a><hr class="navbar-divider"><a href="/docs/layout" class="navbar-item">Layout</a></div></div></div> <div class="navbar-end"><div class="navbar-item"><a class="button">Log in</a></div></div></div></nav>`,
+		},
+		{
+			name: "Exact root match",
+			config: NavConfig{
+				Start:       docs[:1],
+				CurrentPath: "/",
+			},
+			expect: `<nav aria-label="main navigation" class="navbar" role="navigation"><div class="navbar-brand"> <a id="navbar-menu-burger" class="navbar-burger" role="button" aria-label="menu" aria-expanded="false" aria-controls="navbar-menu" tabindex="0"><span aria-hidden="true"></span> <span aria-hidden="true"></span> <span aria-hidden="true"></span> <span aria-hidden="true"></span></a></div> <div id="navbar-menu" class="navbar-menu" aria-labelledby="navbar-menu-burger"><div class="navbar-start"><a href="/" class="navbar-item is-active" aria-current="page">Home</a></div> </div></nav>`,
+		},
+		{
+			name: "Right-aligned dropdown in end section",
+			config: NavConfig{
+				End: []NavItem{{Label: "Account", Children: []NavItem{
+					{Label: "Settings", Href: "/settings"},
+				}}},
+				CurrentPath: "/settings/profile",
+			},
+			expect: `<nav aria-label="main navigation" class="navbar" role="navigation"><div class="navbar-brand"> <a id="navbar-menu-burger" class="navbar-burger" role="button" aria-label="menu" aria-expanded="false" aria-controls="navbar-menu" tabindex="0"><span aria-hidden="true"></span> <span aria-hidden="true"></span> <span aria-hidden="true"></span> <span aria-hidden="true"></span></a></div> <div id="navbar-menu" class="navbar-menu" aria-labelledby="navbar-menu-burger"> <div class="navbar-end"><div class="navbar-item is-hoverable has-dropdown"><a class="navbar-link is-active">Account</a> <div class="navbar-dropdown is-right"><a href="/settings" class="navbar-item is-active" aria-current="page">Settings</a></div></div></div></div></nav>`,
+		},
+		{
+			name: "Custom menu ID and navbar props",
+			config: NavConfig{
+				Navbar: NavbarProps{Color: IsPrimary, AriaLabel: "site"},
+				MenuID: "site-menu",
+			},
+			expect: `<nav aria-label="site" class="navbar is-primary" role="navigation"><div class="navbar-brand"> <a id="site-menu-burger" class="navbar-burger" role="button" aria-label="menu" aria-expanded="false" aria-controls="site-menu" tabindex="0"><span aria-hidden="true"></span> <span aria-hidden="true"></span> <span aria-hidden="true"></span> <span aria-hidden="true"></span></a></div> <div id="site-menu" class="navbar-menu" aria-labelledby="site-menu-burger"> </div></nav>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := Build(tt.config).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestNavbarScript(t *testing.T) {
+	t.Run("Without nonce", func(t *testing.T) {
+		var buf strings.Builder
+		err := NavbarScript().Render(context.Background(), &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		got := buf.String()
+		if !strings.HasPrefix(got, "<script>") {
+			t.Errorf("expected script without nonce, got: %s", got)
+		}
+		if !strings.Contains(got, `setAttribute("aria-expanded", active)`) {
+			t.Errorf("expected script to update aria-expanded, got: %s", got)
+		}
+	})
+
+	t.Run("With nonce", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.WithNonce(context.Background(), "r4nd0m")
+		err := NavbarScript().Render(ctx, &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		if !strings.HasPrefix(buf.String(), `<script nonce="r4nd0m">`) {
+			t.Errorf("expected script with nonce, got: %s", buf.String())
+		}
+	})
+
+	t.Run("Rendered once per context", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.InitializeContext(context.Background())
+		for range 2 {
+			if err := NavbarScript().Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+		}
+		if n := strings.Count(buf.String(), "<script"); n != 1 {
+			t.Errorf("expected script to be rendered once, got %d", n)
+		}
+	})
+}
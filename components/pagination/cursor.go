@@ -0,0 +1,46 @@
+package pagination
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// Query parameter names used to round-trip cursors through the URL.
+const (
+	BeforeParam = "before" // Query parameter holding the cursor of the previous page
+	AfterParam  = "after"  // Query parameter holding the cursor of the next page
+)
+
+// Cursor holds the opaque keyset cursors of a paginated request.
+// At most one of Before and After is normally set; both empty means
+// the first page was requested.
+type Cursor struct {
+	// Cursor to fetch the page before the current one
+	Before string
+
+	// Cursor to fetch the page after the current one
+	After string
+}
+
+// CursorFromRequest reads the before/after cursors from the request
+// query string, e.g. ?after=eyJpZCI6NDJ9.
+func CursorFromRequest(r *http.Request) Cursor {
+	query := r.URL.Query()
+	return Cursor{
+		Before: query.Get(BeforeParam),
+		After:  query.Get(AfterParam),
+	}
+}
+
+// IsFirst reports whether the cursor points at the first page.
+func (c Cursor) IsFirst() bool {
+	return c.Before == "" && c.After == ""
+}
+
+// Helper function to resolve a cursor URL, defaulting to a ?before= or ?after= query string
+func cursorURL(fn func(param, cursor string) string, param, cursor string) string {
+	if fn == nil {
+		return "?" + url.Values{param: {cursor}}.Encode()
+	}
+	return fn(param, cursor)
+}
@@ -0,0 +1,32 @@
+package pagination
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCursorFromRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		expect  Cursor
+		isFirst bool
+	}{
+		{name: "First page", target: "/posts", expect: Cursor{}, isFirst: true},
+		{name: "After cursor", target: "/posts?after=xyz", expect: Cursor{After: "xyz"}},
+		{name: "Before cursor", target: "/posts?before=abc%3D", expect: Cursor{Before: "abc="}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", tt.target, nil)
+			got := CursorFromRequest(r)
+			if got != tt.expect {
+				t.Errorf("expected %+v, got %+v", tt.expect, got)
+			}
+			if got.IsFirst() != tt.isFirst {
+				t.Errorf("expected IsFirst %v, got %v", tt.isFirst, got.IsFirst())
+			}
+		})
+	}
+}
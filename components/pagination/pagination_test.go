@@ -2,6 +2,7 @@ package pagination
 
 import (
 	"context"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -90,6 +91,11 @@ func TestPaginationPrevious(t *testing.T) {
 			props:  PaginationPreviousProps{ID: "prev1", Class: []string{"custom-prev"}},
 			expect: `<a id="prev1" class="pagination-previous custom-prev"></a>`,
 		},
+		{
+			name:   "With href",
+			props:  PaginationPreviousProps{Href: "?page=1"},
+			expect: `<a href="?page=1" class="pagination-previous"></a>`,
+		},
 		{
 			name:   "With disabled state",
 			props:  PaginationPreviousProps{IsDisabled: true},
@@ -138,6 +144,11 @@ func TestPaginationNext(t *testing.T) {
 			props:  PaginationNextProps{ID: "next1", Class: []string{"custom-next"}},
 			expect: `<a id="next1" class="pagination-next custom-next"></a>`,
 		},
+		{
+			name:   "With href",
+			props:  PaginationNextProps{Href: "?page=3"},
+			expect: `<a href="?page=3" class="pagination-next"></a>`,
+		},
 		{
 			name:   "With disabled state",
 			props:  PaginationNextProps{IsDisabled: true},
@@ -227,6 +238,11 @@ func TestPaginationLink(t *testing.T) {
 			props:  PaginationLinkProps{ID: "link1", Class: []string{"custom-link"}},
 			expect: `<a id="link1" class="pagination-link custom-link"></a>`,
 		},
+		{
+			name:   "With href",
+			props:  PaginationLinkProps{Href: "?page=2"},
+			expect: `<a href="?page=2" class="pagination-link"></a>`,
+		},
 		{
 			name:   "With current state",
 			props:  PaginationLinkProps{IsCurrent: true},
@@ -311,3 +327,165 @@ func TestPaginationEllipsis(t *testing.T) {
 		})
 	}
 }
+
+func TestPaginator(t *testing.T) {
+	pageURL := func(page int) string { return "/posts?page=" + strconv.Itoa(page) }
+
+	tests := []struct {
+		name   string
+		props  PaginatorProps
+		expect string
+	}{
+		{
+			name:   "No pages",
+			props:  PaginatorProps{Total: 0},
+			expect: ``,
+		},
+		{
+			name:  "Single page",
+			props: PaginatorProps{Current: 1, Total: 1, URLFunc: pageURL},
+			expect: `<nav class="pagination" role="navigation" aria-label="pagination">` +
+				`<a class="pagination-previous" disabled>Previous</a> <a class="pagination-next" disabled>Next</a> ` +
+				`<ul class="pagination-list"><li><a href="/posts?page=1" class="pagination-link is-current" aria-current="page" aria-label="Page 1">1</a></li></ul></nav>`,
+		},
+		{
+			name:  "First page disables previous",
+			props: PaginatorProps{Current: 1, Total: 3, URLFunc: pageURL},
+			expect: `<nav class="pagination" role="navigation" aria-label="pagination">` +
+				`<a class="pagination-previous" disabled>Previous</a> <a href="/posts?page=2" class="pagination-next">Next</a> ` +
+				`<ul class="pagination-list">` +
+				`<li><a href="/posts?page=1" class="pagination-link is-current" aria-current="page" aria-label="Page 1">1</a></li>` +
+				`<li><a href="/posts?page=2" class="pagination-link" aria-label="Goto page 2">2</a></li>` +
+				`<li><a href="/posts?page=3" class="pagination-link" aria-label="Goto page 3">3</a></li>` +
+				`</ul></nav>`,
+		},
+		{
+			name:  "Last page disables next",
+			props: PaginatorProps{Current: 2, Total: 2, URLFunc: pageURL},
+			expect: `<nav class="pagination" role="navigation" aria-label="pagination">` +
+				`<a href="/posts?page=1" class="pagination-previous">Previous</a> <a class="pagination-next" disabled>Next</a> ` +
+				`<ul class="pagination-list">` +
+				`<li><a href="/posts?page=1" class="pagination-link" aria-label="Goto page 1">1</a></li>` +
+				`<li><a href="/posts?page=2" class="pagination-link is-current" aria-current="page" aria-label="Page 2">2</a></li>` +
+				`</ul></nav>`,
+		},
+		{
+			name:  "Middle page with ellipses",
+			props: PaginatorProps{Current: 5, Total: 10, URLFunc: pageURL},
+			expect: `<nav class="pagination" role="navigation" aria-label="pagination">` +
+				`<a href="/posts?page=4" class="pagination-previous">Previous</a> <a href="/posts?page=6" class="pagination-next">Next</a> ` +
+				`<ul class="pagination-list">` +
+				`<li><a href="/posts?page=1" class="pagination-link" aria-label="Goto page 1">1</a></li>` +
+				`<li><span class="pagination-ellipsis">&hellip;</span></li>` +
+				`<li><a href="/posts?page=4" class="pagination-link" aria-label="Goto page 4">4</a></li>` +
+				`<li><a href="/posts?page=5" class="pagination-link is-current" aria-current="page" aria-label="Page 5">5</a></li>` +
+				`<li><a href="/posts?page=6" class="pagination-link" aria-label="Goto page 6">6</a></li>` +
+				`<li><span class="pagination-ellipsis">&hellip;</span></li>` +
+				`<li><a href="/posts?page=10" class="pagination-link" aria-label="Goto page 10">10</a></li>` +
+				`</ul></nav>`,
+		},
+		{
+			name:  "Window of zero",
+			props: PaginatorProps{Current: 5, Total: 10, Window: &[]int{0}[0], URLFunc: pageURL},
+			expect: `<nav class="pagination" role="navigation" aria-label="pagination">` +
+				`<a href="/posts?page=4" class="pagination-previous">Previous</a> <a href="/posts?page=6" class="pagination-next">Next</a> ` +
+				`<ul class="pagination-list">` +
+				`<li><a href="/posts?page=1" class="pagination-link" aria-label="Goto page 1">1</a></li>` +
+				`<li><span class="pagination-ellipsis">&hellip;</span></li>` +
+				`<li><a href="/posts?page=5" class="pagination-link is-current" aria-current="page" aria-label="Page 5">5</a></li>` +
+				`<li><span class="pagination-ellipsis">&hellip;</span></li>` +
+				`<li><a href="/posts?page=10" class="pagination-link" aria-label="Goto page 10">10</a></li>` +
+				`</ul></nav>`,
+		},
+		{
+			name: "Custom labels, modifiers and default URLs",
+			props: PaginatorProps{
+				ID:            "posts-pagination",
+				Current:       1,
+				Total:         1,
+				PreviousLabel: "Newer",
+				NextLabel:     "Older",
+				Alignment:     IsCentered,
+				Size:          IsSmall,
+				IsRounded:     true,
+			},
+			expect: `<nav id="posts-pagination" class="pagination is-small is-centered is-rounded" role="navigation" aria-label="pagination">` +
+				`<a class="pagination-previous" disabled>Newer</a> <a class="pagination-next" disabled>Older</a> ` +
+				`<ul class="pagination-list"><li><a href="?page=1" class="pagination-link is-current" aria-current="page" aria-label="Page 1">1</a></li></ul></nav>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := Paginator(tt.props).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestCursorPaginator(t *testing.T) {
+	tests := []struct {
+		name   string
+		props  CursorPaginatorProps
+		expect string
+	}{
+		{
+			name:  "No cursors",
+			props: CursorPaginatorProps{},
+			expect: `<nav class="pagination" role="navigation" aria-label="pagination">` +
+				`<a class="pagination-previous" disabled>Previous</a> <a class="pagination-next" disabled>Next</a> </nav>`,
+		},
+		{
+			name:  "Both cursors with default URLs",
+			props: CursorPaginatorProps{Before: "abc=", After: "xyz"},
+			expect: `<nav class="pagination" role="navigation" aria-label="pagination">` +
+				`<a href="?before=abc%3D" class="pagination-previous">Previous</a> <a href="?after=xyz" class="pagination-next">Next</a> </nav>`,
+		},
+		{
+			name: "Custom URL function and labels",
+			props: CursorPaginatorProps{
+				After:         "xyz",
+				URLFunc:       func(param, cursor string) string { return "/posts?" + param + "=" + cursor },
+				PreviousLabel: "Newer",
+				NextLabel:     "Older",
+			},
+			expect: `<nav class="pagination" role="navigation" aria-label="pagination">` +
+				`<a class="pagination-previous" disabled>Newer</a> <a href="/posts?after=xyz" class="pagination-next">Older</a> </nav>`,
+		},
+		{
+			name:  "First page link on first page",
+			props: CursorPaginatorProps{After: "xyz", FirstURL: "/posts"},
+			expect: `<nav class="pagination" role="navigation" aria-label="pagination">` +
+				`<a class="pagination-previous" disabled>Previous</a> <a href="?after=xyz" class="pagination-next">Next</a> ` +
+				`<ul class="pagination-list"><li><a href="/posts" class="pagination-link is-current" aria-current="page" aria-label="Goto first page">First</a></li></ul></nav>`,
+		},
+		{
+			name:  "First page link on a later page",
+			props: CursorPaginatorProps{Before: "abc", FirstURL: "/posts", FirstLabel: "Latest", IsRounded: true},
+			expect: `<nav class="pagination is-rounded" role="navigation" aria-label="pagination">` +
+				`<a href="?before=abc" class="pagination-previous">Previous</a> <a class="pagination-next" disabled>Next</a> ` +
+				`<ul class="pagination-list"><li><a href="/posts" class="pagination-link" aria-label="Goto first page">Latest</a></li></ul></nav>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := CursorPaginator(tt.props).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package pagination
+
+// Ellipsis marks a gap between page numbers in a page window.
+const Ellipsis = 0
+
+// Window computes the page numbers to display for numbered pagination.
+//
+// The first and last pages are always included, along with up to
+// window pages on each side of the current page. Gaps are marked with
+// Ellipsis, except when the gap would hide a single page, in which
+// case that page is shown instead. The current page is clamped to the
+// range 1..total, and a total below 1 returns nil.
+//
+// For example, Window(5, 10, 1) returns [1 0 4 5 6 0 10].
+func Window(current, total, window int) []int {
+	if total < 1 {
+		return nil
+	}
+	if window < 0 {
+		window = 0
+	}
+	current = min(max(current, 1), total)
+
+	start := max(current-window, 2)
+	end := min(current+window, total-1)
+
+	// Show the page instead of an ellipsis when the gap is a single page
+	if start == 3 {
+		start = 2
+	}
+	if end == total-2 {
+		end = total - 1
+	}
+
+	pages := []int{1}
+	if start > 2 {
+		pages = append(pages, Ellipsis)
+	}
+	for page := start; page <= end; page++ {
+		pages = append(pages, page)
+	}
+	if end < total-1 {
+		pages = append(pages, Ellipsis)
+	}
+	if total > 1 {
+		pages = append(pages, total)
+	}
+	return pages
+}
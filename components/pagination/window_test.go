@@ -0,0 +1,42 @@
+package pagination
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWindow(t *testing.T) {
+	const E = Ellipsis
+
+	tests := []struct {
+		name    string
+		current int
+		total   int
+		window  int
+		expect  []int
+	}{
+		{name: "No pages", current: 1, total: 0, window: 1, expect: nil},
+		{name: "Single page", current: 1, total: 1, window: 1, expect: []int{1}},
+		{name: "Two pages", current: 2, total: 2, window: 1, expect: []int{1, 2}},
+		{name: "All pages fit", current: 3, total: 5, window: 1, expect: []int{1, 2, 3, 4, 5}},
+		{name: "First page", current: 1, total: 10, window: 1, expect: []int{1, 2, E, 10}},
+		{name: "Last page", current: 10, total: 10, window: 1, expect: []int{1, E, 9, 10}},
+		{name: "Middle page", current: 5, total: 10, window: 1, expect: []int{1, E, 4, 5, 6, E, 10}},
+		{name: "Single hidden page is shown instead of ellipsis", current: 4, total: 10, window: 1, expect: []int{1, 2, 3, 4, 5, E, 10}},
+		{name: "Single hidden page near the end", current: 7, total: 10, window: 1, expect: []int{1, E, 6, 7, 8, 9, 10}},
+		{name: "Wider window", current: 10, total: 20, window: 2, expect: []int{1, E, 8, 9, 10, 11, 12, E, 20}},
+		{name: "Zero window", current: 5, total: 10, window: 0, expect: []int{1, E, 5, E, 10}},
+		{name: "Negative window is treated as zero", current: 5, total: 10, window: -3, expect: []int{1, E, 5, E, 10}},
+		{name: "Current below range is clamped", current: -4, total: 10, window: 1, expect: []int{1, 2, E, 10}},
+		{name: "Current above range is clamped", current: 42, total: 10, window: 1, expect: []int{1, E, 9, 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Window(tt.current, tt.total, tt.window)
+			if !slices.Equal(got, tt.expect) {
+				t.Errorf("expected %v, got %v", tt.expect, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package tabs
+
+import (
+	"net/http"
+	"slices"
+)
+
+// TabParam is the query parameter holding the active tab for server-driven tabs.
+const TabParam = "tab"
+
+// ActiveTab reads the active tab key from the request query string.
+//
+// It returns the value of ?tab= when it is one of the given keys, and
+// the first key otherwise, so an unknown or missing tab falls back to
+// the default. Use it with Tab hrefs such as "?tab=settings" to switch
+// tabs without JavaScript.
+func ActiveTab(r *http.Request, keys ...string) string {
+	if len(keys) == 0 {
+		return ""
+	}
+	if key := r.URL.Query().Get(TabParam); slices.Contains(keys, key) {
+		return key
+	}
+	return keys[0]
+}
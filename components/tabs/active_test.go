@@ -0,0 +1,29 @@
+package tabs
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestActiveTab(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		keys   []string
+		expect string
+	}{
+		{name: "Missing parameter defaults to first tab", target: "/settings", keys: []string{"profile", "security"}, expect: "profile"},
+		{name: "Known tab", target: "/settings?tab=security", keys: []string{"profile", "security"}, expect: "security"},
+		{name: "Unknown tab falls back to first tab", target: "/settings?tab=billing", keys: []string{"profile", "security"}, expect: "profile"},
+		{name: "No keys", target: "/settings?tab=security", keys: nil, expect: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", tt.target, nil)
+			if got := ActiveTab(r, tt.keys...); got != tt.expect {
+				t.Errorf("expected %q, got %q", tt.expect, got)
+			}
+		})
+	}
+}
@@ -84,6 +84,11 @@ func TestTabs(t *testing.T) {
 			},
 			expect: `<div class="tabs is-large is-centered is-boxed is-fullwidth"></div>`,
 		},
+		{
+			name:   "Client-side switching",
+			props:  TabsProps{IsClientSide: true},
+			expect: `<div class="tabs" data-tabs></div>`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -160,3 +165,171 @@ func TestTabsConstants(t *testing.T) {
 		}
 	}
 }
+
+func TestTabList(t *testing.T) {
+	tests := []struct {
+		name   string
+		props  TabListProps
+		expect string
+	}{
+		{
+			name:   "Default",
+			props:  TabListProps{},
+			expect: `<ul role="tablist"></ul>`,
+		},
+		{
+			name: "All fields combined",
+			props: TabListProps{
+				ID:         "tablist1",
+				Class:      []string{"foo"},
+				Attributes: templ.Attributes{"aria-label": "Settings"},
+			},
+			expect: `<ul id="tablist1" class="foo" role="tablist" aria-label="Settings"></ul>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := TabList(tt.props).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected: %s, got: %s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestTab(t *testing.T) {
+	tests := []struct {
+		name   string
+		props  TabProps
+		expect string
+	}{
+		{
+			name:   "Inactive tab",
+			props:  TabProps{Href: "?tab=profile"},
+			expect: `<li role="presentation"><a href="?tab=profile" role="tab" aria-selected="false" tabindex="-1"></a></li>`,
+		},
+		{
+			name:   "Active tab",
+			props:  TabProps{Href: "?tab=profile", IsActive: true},
+			expect: `<li class="is-active" role="presentation"><a href="?tab=profile" role="tab" aria-selected="true"></a></li>`,
+		},
+		{
+			name: "All fields combined",
+			props: TabProps{
+				ID:         "tab-profile",
+				Class:      []string{"foo"},
+				Href:       "#panel-profile",
+				Controls:   "panel-profile",
+				IsActive:   true,
+				Attributes: templ.Attributes{"data-role": "tab"},
+			},
+			expect: `<li class="is-active foo" role="presentation"><a id="tab-profile" href="#panel-profile" role="tab" aria-selected="true" aria-controls="panel-profile" data-role="tab"></a></li>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := Tab(tt.props).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected: %s, got: %s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestTabPanel(t *testing.T) {
+	tests := []struct {
+		name   string
+		props  TabPanelProps
+		expect string
+	}{
+		{
+			name:   "Inactive panel is hidden",
+			props:  TabPanelProps{},
+			expect: `<div role="tabpanel" hidden></div>`,
+		},
+		{
+			name:   "Active panel",
+			props:  TabPanelProps{IsActive: true},
+			expect: `<div role="tabpanel"></div>`,
+		},
+		{
+			name: "All fields combined",
+			props: TabPanelProps{
+				ID:         "panel-profile",
+				Class:      []string{"box"},
+				LabelledBy: "tab-profile",
+				IsActive:   true,
+				Attributes: templ.Attributes{"data-role": "panel"},
+			},
+			expect: `<div id="panel-profile" class="box" role="tabpanel" aria-labelledby="tab-profile" data-role="panel"></div>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := TabPanel(tt.props).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected: %s, got: %s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestTabsScript(t *testing.T) {
+	t.Run("Without nonce", func(t *testing.T) {
+		var buf strings.Builder
+		err := TabsScript().Render(context.Background(), &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		got := buf.String()
+		if !strings.HasPrefix(got, "<script>") {
+			t.Errorf("expected script without nonce, got: %s", got)
+		}
+		if !strings.Contains(got, `[data-tabs] [role="tab"]`) {
+			t.Errorf("expected script to target client-side tabs, got: %s", got)
+		}
+	})
+
+	t.Run("With nonce", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.WithNonce(context.Background(), "r4nd0m")
+		err := TabsScript().Render(ctx, &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		if !strings.HasPrefix(buf.String(), `<script nonce="r4nd0m">`) {
+			t.Errorf("expected script with nonce, got: %s", buf.String())
+		}
+	})
+
+	t.Run("Rendered once per context", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.InitializeContext(context.Background())
+		for range 2 {
+			if err := TabsScript().Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+		}
+		if n := strings.Count(buf.String(), "<script"); n != 1 {
+			t.Errorf("expected script to be rendered once, got %d", n)
+		}
+	})
+}
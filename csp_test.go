@@ -0,0 +1,124 @@
+package templaui
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNonce(t *testing.T) {
+	if got := Nonce(context.Background()); got != "" {
+		t.Errorf("expected empty nonce, got %q", got)
+	}
+	ctx := WithNonce(context.Background(), "r4nd0m")
+	if got := Nonce(ctx); got != "r4nd0m" {
+		t.Errorf("expected %q, got %q", "r4nd0m", got)
+	}
+}
+
+func TestNewNonce(t *testing.T) {
+	a, err := NewNonce()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, err := NewNonce()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a == b {
+		t.Errorf("expected distinct nonces, got %q twice", a)
+	}
+	raw, err := base64.StdEncoding.DecodeString(a)
+	if err != nil || len(raw) != 16 {
+		t.Errorf("expected 16 base64-encoded bytes, got %q", a)
+	}
+}
+
+func TestContentSecurityPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		nonce  string
+		opts   []CSPOptions
+		expect string
+	}{
+		{
+			name:   "default",
+			nonce:  "r4nd0m",
+			expect: "default-src 'self'; script-src 'self' 'nonce-r4nd0m'; style-src 'self' 'nonce-r4nd0m' https://cdnjs.cloudflare.com; img-src 'self' data: blob:; font-src 'self'; connect-src 'self'; object-src 'none'; base-uri 'self'; form-action 'self'; frame-ancestors 'self'",
+		},
+		{
+			name:   "no nonce",
+			expect: "default-src 'self'; script-src 'self'; style-src 'self' https://cdnjs.cloudflare.com; img-src 'self' data: blob:; font-src 'self'; connect-src 'self'; object-src 'none'; base-uri 'self'; form-action 'self'; frame-ancestors 'self'",
+		},
+		{
+			name:   "cdn",
+			nonce:  "r4nd0m",
+			opts:   []CSPOptions{{CDN: JSDelivr}},
+			expect: "default-src 'self'; script-src 'self' 'nonce-r4nd0m'; style-src 'self' 'nonce-r4nd0m' https://cdn.jsdelivr.net; img-src 'self' data: blob:; font-src 'self'; connect-src 'self'; object-src 'none'; base-uri 'self'; form-action 'self'; frame-ancestors 'self'",
+		},
+		{
+			name:  "extra sources",
+			nonce: "r4nd0m",
+			opts: []CSPOptions{{
+				NoCDN:          true,
+				ScriptSrc:      []string{"https://unpkg.com"},
+				StyleSrc:       []string{"https://fonts.googleapis.com"},
+				ImgSrc:         []string{"https:"},
+				FontSrc:        []string{"https://fonts.gstatic.com"},
+				ConnectSrc:     []string{"wss://example.com"},
+				FormAction:     []string{"https://checkout.example.com"},
+				FrameAncestors: []string{"https://portal.example.com"},
+			}},
+			expect: "default-src 'self'; script-src 'self' 'nonce-r4nd0m' https://unpkg.com; style-src 'self' 'nonce-r4nd0m' https://fonts.googleapis.com; img-src 'self' data: blob: https:; font-src 'self' https://fonts.gstatic.com; connect-src 'self' wss://example.com; object-src 'none'; base-uri 'self'; form-action 'self' https://checkout.example.com; frame-ancestors 'self' https://portal.example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ContentSecurityPolicy(tt.nonce, tt.opts...)
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestNonceMiddleware(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   []CSPOptions
+		header string
+	}{
+		{name: "enforce", header: "Content-Security-Policy"},
+		{name: "report only", opts: []CSPOptions{{ReportOnly: true}}, header: "Content-Security-Policy-Report-Only"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nonce string
+			var buf bytes.Buffer
+			h := NonceMiddleware(tt.opts...)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				nonce = Nonce(r.Context())
+				if err := ThemeScript().Render(r.Context(), &buf); err != nil {
+					t.Fatalf("failed to render: %v", err)
+				}
+			}))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			if nonce == "" {
+				t.Fatal("expected a nonce in the request context")
+			}
+			if got, want := rec.Header().Get(tt.header), ContentSecurityPolicy(nonce, tt.opts...); got != want {
+				t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+			}
+			if !strings.Contains(buf.String(), `<script nonce="`+nonce+`">`) {
+				t.Errorf("expected script with nonce %q, got:\n%s", nonce, buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package table
+
+import (
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+)
+
+// Query parameter names used to round-trip sort state through the URL.
+const (
+	SortParam      = "sort" // Query parameter holding the sorted column key
+	DirectionParam = "dir"  // Query parameter holding the sort direction
+	PageParam      = "page" // Query parameter of the page number, dropped from sort links
+)
+
+// SortDirection represents the direction of a sorted column
+type SortDirection string
+
+const (
+	SortAsc  SortDirection = "asc"  // Ascending sort order
+	SortDesc SortDirection = "desc" // Descending sort order
+)
+
+// Sort describes the current sort state of a table.
+//
+// Use this type to mark sortable headers with the active column and
+// direction, and to build the links that toggle between ascending and
+// descending order. Query holds any other parameters (filters, page
+// size, ...) that should be preserved in generated sort links, except
+// the page number, since a new order starts over on the first page.
+type Sort struct {
+	// Key of the currently sorted column (empty when unsorted)
+	Key string
+
+	// Direction of the current sort (defaults to ascending)
+	Direction SortDirection
+
+	// Additional query parameters preserved in generated sort links
+	Query url.Values
+
+	// Query parameter of the page number, removed from sort links (defaults to PageParam)
+	PageParam string
+}
+
+// SortFromRequest reads sort state from the request query string.
+//
+// It parses ?sort=name&dir=desc into a Sort, keeping the remaining query
+// parameters so generated links preserve them. When allowed keys are
+// given, any other sort key is ignored so the value can be used safely
+// for server-side ordering. Unknown directions fall back to ascending.
+func SortFromRequest(r *http.Request, allowed ...string) Sort {
+	query := r.URL.Query()
+	s := Sort{
+		Key:       query.Get(SortParam),
+		Direction: SortDirection(query.Get(DirectionParam)),
+		Query:     query,
+	}
+	if len(allowed) > 0 && !slices.Contains(allowed, s.Key) {
+		s.Key = ""
+	}
+	if s.Direction != SortDesc {
+		s.Direction = SortAsc
+	}
+	return s
+}
+
+// DirectionFor returns the sort direction of the given column key,
+// or an empty direction when the table is not sorted by that key.
+func (s Sort) DirectionFor(key string) SortDirection {
+	if key == "" || s.Key != key {
+		return ""
+	}
+	if s.Direction == SortDesc {
+		return SortDesc
+	}
+	return SortAsc
+}
+
+// URL returns the relative link that sorts the table by the given key.
+// Clicking a column that is already sorted ascending switches it to
+// descending; any other column starts in ascending order. The page
+// parameter is dropped so the link leads to the first page of the new
+// order.
+func (s Sort) URL(key string) string {
+	dir := SortAsc
+	if s.DirectionFor(key) == SortAsc {
+		dir = SortDesc
+	}
+	query := url.Values{}
+	maps.Copy(query, s.Query)
+	page := s.PageParam
+	if page == "" {
+		page = PageParam
+	}
+	query.Del(page)
+	query.Set(SortParam, key)
+	query.Set(DirectionParam, string(dir))
+	return "?" + query.Encode()
+}
+
+// Helper function to convert a sort direction to its aria-sort value
+func ariaSort(dir SortDirection) string {
+	switch dir {
+	case SortAsc:
+		return "ascending"
+	case SortDesc:
+		return "descending"
+	default:
+		return ""
+	}
+}
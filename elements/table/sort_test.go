@@ -0,0 +1,155 @@
+package table
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestSortFromRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  string
+		allowed []string
+		expect  Sort
+	}{
+		{
+			name:   "No sort parameters",
+			target: "/users",
+			expect: Sort{Direction: SortAsc},
+		},
+		{
+			name:   "Key and descending direction",
+			target: "/users?sort=name&dir=desc",
+			expect: Sort{Key: "name", Direction: SortDesc},
+		},
+		{
+			name:   "Key without direction defaults to ascending",
+			target: "/users?sort=name",
+			expect: Sort{Key: "name", Direction: SortAsc},
+		},
+		{
+			name:   "Invalid direction falls back to ascending",
+			target: "/users?sort=name&dir=sideways",
+			expect: Sort{Key: "name", Direction: SortAsc},
+		},
+		{
+			name:    "Allowed key",
+			target:  "/users?sort=email&dir=desc",
+			allowed: []string{"name", "email"},
+			expect:  Sort{Key: "email", Direction: SortDesc},
+		},
+		{
+			name:    "Disallowed key is ignored",
+			target:  "/users?sort=password&dir=desc",
+			allowed: []string{"name", "email"},
+			expect:  Sort{Direction: SortDesc},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", tt.target, nil)
+			got := SortFromRequest(r, tt.allowed...)
+			if got.Key != tt.expect.Key {
+				t.Errorf("expected key %q, got %q", tt.expect.Key, got.Key)
+			}
+			if got.Direction != tt.expect.Direction {
+				t.Errorf("expected direction %q, got %q", tt.expect.Direction, got.Direction)
+			}
+		})
+	}
+}
+
+func TestSortDirectionFor(t *testing.T) {
+	tests := []struct {
+		name   string
+		sort   Sort
+		key    string
+		expect SortDirection
+	}{
+		{name: "Unsorted", sort: Sort{}, key: "name", expect: ""},
+		{name: "Other column", sort: Sort{Key: "email", Direction: SortDesc}, key: "name", expect: ""},
+		{name: "Ascending", sort: Sort{Key: "name", Direction: SortAsc}, key: "name", expect: SortAsc},
+		{name: "Empty direction means ascending", sort: Sort{Key: "name"}, key: "name", expect: SortAsc},
+		{name: "Descending", sort: Sort{Key: "name", Direction: SortDesc}, key: "name", expect: SortDesc},
+		{name: "Empty key", sort: Sort{}, key: "", expect: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sort.DirectionFor(tt.key); got != tt.expect {
+				t.Errorf("expected %q, got %q", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestSortURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		sort   Sort
+		key    string
+		expect string
+	}{
+		{
+			name:   "Unsorted column starts ascending",
+			sort:   Sort{},
+			key:    "name",
+			expect: "?dir=asc&sort=name",
+		},
+		{
+			name:   "Ascending column toggles to descending",
+			sort:   Sort{Key: "name", Direction: SortAsc},
+			key:    "name",
+			expect: "?dir=desc&sort=name",
+		},
+		{
+			name:   "Descending column toggles to ascending",
+			sort:   Sort{Key: "name", Direction: SortDesc},
+			key:    "name",
+			expect: "?dir=asc&sort=name",
+		},
+		{
+			name:   "Other column starts ascending",
+			sort:   Sort{Key: "name", Direction: SortAsc},
+			key:    "email",
+			expect: "?dir=asc&sort=email",
+		},
+		{
+			name:   "Preserves other query parameters",
+			sort:   Sort{Key: "name", Direction: SortAsc, Query: url.Values{"q": {"bob"}, "sort": {"name"}}},
+			key:    "name",
+			expect: "?dir=desc&q=bob&sort=name",
+		},
+		{
+			name:   "Drops the page number",
+			sort:   Sort{Query: url.Values{"q": {"bob"}, "page": {"5"}}},
+			key:    "name",
+			expect: "?dir=asc&q=bob&sort=name",
+		},
+		{
+			name:   "Drops a custom page parameter",
+			sort:   Sort{Query: url.Values{"page": {"5"}, "p": {"5"}}, PageParam: "p"},
+			key:    "name",
+			expect: "?dir=asc&page=5&sort=name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sort.URL(tt.key); got != tt.expect {
+				t.Errorf("expected %q, got %q", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestSortURLDoesNotMutateQuery(t *testing.T) {
+	query := url.Values{"q": {"bob"}}
+	s := Sort{Query: query}
+	_ = s.URL("name")
+	if len(query) != 1 {
+		t.Errorf("expected query to be left untouched, got %v", query)
+	}
+}
@@ -507,3 +507,174 @@ func TestTableColors(t *testing.T) {
 		}
 	})
 }
+
+type user struct {
+	Name   string
+	Email  string
+	Active bool
+}
+
+func TestDataTable(t *testing.T) {
+	columns := []Column[user]{
+		{
+			Label: "Name",
+			Cell:  func(u user) templ.Component { return templ.Raw(u.Name) },
+		},
+		{
+			Label:     "Email",
+			Cell:      func(u user) templ.Component { return templ.Raw(u.Email) },
+			Alignment: HasTextRight,
+			IsNarrow:  true,
+		},
+	}
+	users := []user{
+		{Name: "Alice", Email: "alice@example.com", Active: true},
+		{Name: "Bob", Email: "bob@example.com"},
+	}
+
+	tests := []struct {
+		name   string
+		props  DataTableProps[user]
+		expect string
+	}{
+		{
+			name:   "Default",
+			props:  DataTableProps[user]{Columns: columns, Rows: users},
+			expect: `<table class="table"><thead><tr><th scope="col">Name</th><th class="is-narrow has-text-right" scope="col">Email</th></tr></thead> <tbody><tr><td>Alice</td><td class="is-narrow has-text-right">alice@example.com</td></tr><tr><td>Bob</td><td class="is-narrow has-text-right">bob@example.com</td></tr> </tbody> </table>`,
+		},
+		{
+			name: "With table modifiers and container",
+			props: DataTableProps[user]{
+				Table:        TableProps{ID: "users", IsStriped: true, IsFullwidth: true},
+				Columns:      columns[:1],
+				Rows:         users[:1],
+				HasContainer: true,
+			},
+			expect: `<div class="table-container"><table id="users" class="table is-fullwidth is-striped"><thead><tr><th scope="col">Name</th></tr></thead> <tbody><tr><td>Alice</td></tr> </tbody> </table></div>`,
+		},
+		{
+			name: "With row and cell colors",
+			props: DataTableProps[user]{
+				Columns: []Column[user]{{
+					Label: "Name",
+					Cell:  func(u user) templ.Component { return templ.Raw(u.Name) },
+					Color: func(u user) Color {
+						if !u.Active {
+							return IsDanger
+						}
+						return ""
+					},
+				}},
+				Rows: users,
+				RowColor: func(u user) Color {
+					if u.Active {
+						return IsSuccess
+					}
+					return ""
+				},
+			},
+			expect: `<table class="table"><thead><tr><th scope="col">Name</th></tr></thead> <tbody><tr class="is-success"><td>Alice</td></tr><tr><td class="is-danger">Bob</td></tr> </tbody> </table>`,
+		},
+		{
+			name: "With footer",
+			props: DataTableProps[user]{
+				Columns: []Column[user]{
+					{Label: "Name", Cell: func(u user) templ.Component { return templ.Raw(u.Name) }, Footer: templ.Raw("Total")},
+					{Label: "Email", Cell: func(u user) templ.Component { return templ.Raw(u.Email) }},
+				},
+				Rows: users[:1],
+			},
+			expect: `<table class="table"><thead><tr><th scope="col">Name</th><th scope="col">Email</th></tr></thead> <tbody><tr><td>Alice</td><td>alice@example.com</td></tr> </tbody> <tfoot><tr><td>Total</td><td></td></tr></tfoot></table>`,
+		},
+		{
+			name: "Empty rows with placeholder",
+			props: DataTableProps[user]{
+				Columns: columns,
+				Empty:   templ.Raw("No users found"),
+			},
+			expect: `<table class="table"><thead><tr><th scope="col">Name</th><th class="is-narrow has-text-right" scope="col">Email</th></tr></thead> <tbody> <tr><td class="has-text-centered" colspan="2">No users found</td></tr></tbody> </table>`,
+		},
+		{
+			name:   "Empty rows without placeholder",
+			props:  DataTableProps[user]{Columns: columns[:1]},
+			expect: `<table class="table"><thead><tr><th scope="col">Name</th></tr></thead> <tbody> </tbody> </table>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := DataTable(tt.props).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestSortableHeader(t *testing.T) {
+	tests := []struct {
+		name   string
+		props  HeaderProps
+		expect string
+	}{
+		{
+			name:   "Sortable and unsorted",
+			props:  HeaderProps{SortKey: "name"},
+			expect: `<th><a href="?dir=asc&amp;sort=name">Name</a></th>`,
+		},
+		{
+			name:   "Sorted ascending",
+			props:  HeaderProps{SortKey: "name", Sort: Sort{Key: "name", Direction: SortAsc}},
+			expect: `<th aria-sort="ascending"><a href="?dir=desc&amp;sort=name">Name<span class="icon is-small" aria-hidden="true">▲</span></a></th>`,
+		},
+		{
+			name:   "Sorted descending",
+			props:  HeaderProps{SortKey: "name", Sort: Sort{Key: "name", Direction: SortDesc}},
+			expect: `<th aria-sort="descending"><a href="?dir=asc&amp;sort=name">Name<span class="icon is-small" aria-hidden="true">▼</span></a></th>`,
+		},
+		{
+			name:   "Sorted by another column",
+			props:  HeaderProps{Scope: "col", SortKey: "name", Sort: Sort{Key: "email", Direction: SortDesc}},
+			expect: `<th scope="col"><a href="?dir=asc&amp;sort=name">Name</a></th>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			ctx := templ.WithChildren(context.Background(), templ.Raw("Name"))
+			err := Header(tt.props).Render(ctx, &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestDataTableSortable(t *testing.T) {
+	var buf strings.Builder
+	err := DataTable(DataTableProps[user]{
+		Columns: []Column[user]{
+			{Label: "Name", SortKey: "name", Cell: func(u user) templ.Component { return templ.Raw(u.Name) }},
+			{Label: "Email", Cell: func(u user) templ.Component { return templ.Raw(u.Email) }},
+		},
+		Sort: Sort{Key: "name", Direction: SortDesc},
+	}).Render(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	got := buf.String()
+	expect := `<thead><tr><th scope="col" aria-sort="descending"><a href="?dir=asc&amp;sort=name">Name<span class="icon is-small" aria-hidden="true">▼</span></a></th><th scope="col">Email</th></tr></thead>`
+	if !strings.Contains(got, expect) {
+		t.Errorf("expected to contain:\n%s\ngot:\n%s", expect, got)
+	}
+}
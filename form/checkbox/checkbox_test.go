@@ -62,6 +62,11 @@ func TestCheckbox(t *testing.T) {
 			},
 			expect: `<label id="terms-checkbox" class="checkbox form-control required"><input type="checkbox" name="terms" value="accepted" checked required></label>`,
 		},
+		{
+			name:   "With errors",
+			props:  CheckboxProps{Name: "email", Errors: map[string][]string{"email": {"is required"}}},
+			expect: `<label class="checkbox"><input type="checkbox" name="email" aria-invalid="true" aria-describedby="email-error"></label><p id="email-error" class="help is-danger">is required </p>`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -88,12 +93,12 @@ func TestCheckboxes(t *testing.T) {
 		{
 			name:   "Default",
 			props:  CheckboxesProps{},
-			expect: `<div class="checkboxes"></div>`,
+			expect: `<div class="checkboxes" role="group"></div>`,
 		},
 		{
 			name:   "With ID and classes",
 			props:  CheckboxesProps{ID: "test-checkboxes", Class: []string{"custom", "form-group"}},
-			expect: `<div id="test-checkboxes" class="checkboxes custom form-group"></div>`,
+			expect: `<div id="test-checkboxes" class="checkboxes custom form-group" role="group"></div>`,
 		},
 		{
 			name: "Complete configuration",
@@ -101,7 +106,12 @@ func TestCheckboxes(t *testing.T) {
 				ID:    "interests-checkboxes",
 				Class: []string{"form-group", "multi-select"},
 			},
-			expect: `<div id="interests-checkboxes" class="checkboxes form-group multi-select"></div>`,
+			expect: `<div id="interests-checkboxes" class="checkboxes form-group multi-select" role="group"></div>`,
+		},
+		{
+			name:   "With errors",
+			props:  CheckboxesProps{Name: "email", Errors: map[string][]string{"email": {"is required"}}},
+			expect: `<div class="checkboxes" role="group" aria-invalid="true" aria-describedby="email-error"></div><p id="email-error" class="help is-danger">is required </p>`,
 		},
 	}
 
@@ -279,3 +289,62 @@ func TestCheckboxAttributes(t *testing.T) {
 		}
 	}
 }
+
+func TestGroup(t *testing.T) {
+	options := []Option{{Value: "go", Label: "Go"}, {Value: "templ", Label: "Templ"}, {Value: "bulma", Label: "Bulma"}}
+	tests := []struct {
+		name   string
+		props  GroupProps
+		expect string
+	}{
+		{
+			name:   "Empty",
+			props:  GroupProps{},
+			expect: `<fieldset class=""><div class="checkboxes"></div></fieldset>`,
+		},
+		{
+			name:   "Options with checked values",
+			props:  GroupProps{Name: "tags", Legend: "Tags", Options: options, Values: []string{"go", "bulma"}},
+			expect: `<fieldset class=""><legend class="label">Tags</legend><div class="checkboxes"><label class="checkbox"><input id="tags-0" type="checkbox" name="tags" value="go" checked> Go</label><label class="checkbox"><input id="tags-1" type="checkbox" name="tags" value="templ"> Templ</label><label class="checkbox"><input id="tags-2" type="checkbox" name="tags" value="bulma" checked> Bulma</label></div></fieldset>`,
+		},
+		{
+			name:   "IDs prefixed with group ID",
+			props:  GroupProps{ID: "post-tags", Name: "tags", Options: options[:1]},
+			expect: `<fieldset id="post-tags" class=""><div class="checkboxes"><label class="checkbox"><input id="post-tags-0" type="checkbox" name="tags" value="go"> Go</label></div></fieldset>`,
+		},
+		{
+			name:   "Disabled option and label defaulting to value",
+			props:  GroupProps{Name: "tags", Options: []Option{{Value: "rust", Disabled: true}}},
+			expect: `<fieldset class=""><div class="checkboxes"><label class="checkbox"><input id="tags-0" type="checkbox" name="tags" value="rust" disabled> rust</label></div></fieldset>`,
+		},
+		{
+			name:   "Stacked and disabled",
+			props:  GroupProps{Name: "tags", Options: options[:1], IsStacked: true, Disabled: true},
+			expect: `<fieldset disabled class=""><div class="checkboxes is-flex-direction-column"><label class="checkbox"><input id="tags-0" type="checkbox" name="tags" value="go"> Go</label></div></fieldset>`,
+		},
+		{
+			name:   "With errors",
+			props:  GroupProps{Name: "tags", Options: options[:1], Errors: map[string][]string{"tags": {"pick one"}}},
+			expect: `<fieldset class="" aria-describedby="tags-error"><div class="checkboxes"><label class="checkbox"><input id="tags-0" type="checkbox" name="tags" value="go" aria-invalid="true"> Go</label></div></fieldset><p id="tags-error" class="help is-danger">pick one </p>`,
+		},
+		{
+			name:   "Custom class and attributes",
+			props:  GroupProps{Class: []string{"custom"}, Attributes: templ.Attributes{"data-test": "value"}},
+			expect: `<fieldset class="custom" data-test="value"><div class="checkboxes"></div></fieldset>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := Group(tt.props).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
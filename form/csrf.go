@@ -0,0 +1,89 @@
+package form
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// CSRFFieldName is the default name of the hidden CSRF token input.
+const CSRFFieldName = "csrf_token"
+
+type csrfKey struct{}
+
+// csrfValue is the CSRF token and field name stored in a context.
+type csrfValue struct {
+	token string
+	name  string
+}
+
+// WithCSRFToken returns a copy of ctx carrying the CSRF token that Form
+// and CSRF render when no token is given explicitly, along with the
+// name of the field the CSRF middleware reads it from. An empty name
+// selects CSRFFieldName.
+func WithCSRFToken(ctx context.Context, token, name string) context.Context {
+	return context.WithValue(ctx, csrfKey{}, csrfValue{token: token, name: name})
+}
+
+// CSRFToken returns the CSRF token stored in ctx with WithCSRFToken,
+// or an empty string if there is none.
+func CSRFToken(ctx context.Context) string {
+	v, _ := ctx.Value(csrfKey{}).(csrfValue)
+	return v.token
+}
+
+// CSRFFieldNameFrom returns the CSRF field name stored in ctx with
+// WithCSRFToken, or CSRFFieldName if there is none.
+func CSRFFieldNameFrom(ctx context.Context) string {
+	if v, _ := ctx.Value(csrfKey{}).(csrfValue); v.name != "" {
+		return v.name
+	}
+	return CSRFFieldName
+}
+
+// CSRFMiddleware stores the token returned by token and the field name
+// in each request's context, so every Form rendered for the request
+// includes the token without threading it, or the field name, through
+// templates. It pairs with token-based CSRF middleware, e.g.
+// form.CSRFMiddleware(csrf.Token, "gorilla.csrf.Token") with
+// gorilla/csrf; an empty name selects CSRFFieldName. net/http's
+// CrossOriginProtection checks request headers instead and needs no
+// token, so no field is rendered when token returns an empty string.
+func CSRFMiddleware(token func(r *http.Request) string, name string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if t := token(r); t != "" {
+				r = r.WithContext(WithCSRFToken(r.Context(), t, name))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Helper function to resolve the CSRF token a form should include.
+// Tokens from the context are only sent with state-changing methods to
+// same-origin actions, so they never leak into URLs or to other sites.
+func formCSRFToken(ctx context.Context, p FormProps) string {
+	if p.CSRFToken != "" {
+		return p.CSRFToken
+	}
+	switch strings.ToLower(p.Method) {
+	case "", "get", "dialog":
+		return ""
+	}
+	if !isRelativeURL(p.Action) {
+		return ""
+	}
+	return CSRFToken(ctx)
+}
+
+// Helper function to report whether a form action stays on the current origin
+func isRelativeURL(action string) bool {
+	if strings.HasPrefix(action, "//") || strings.HasPrefix(action, `/\`) {
+		return false
+	}
+	if i := strings.IndexAny(action, ":/?#"); i >= 0 && action[i] == ':' {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package form
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCSRFToken(t *testing.T) {
+	ctx := context.Background()
+	if got := CSRFToken(ctx); got != "" {
+		t.Errorf("expected no token, got %q", got)
+	}
+	if got := CSRFFieldNameFrom(ctx); got != CSRFFieldName {
+		t.Errorf("expected default field name, got %q", got)
+	}
+	ctx = WithCSRFToken(ctx, "t0k3n", "gorilla.csrf.Token")
+	if got := CSRFToken(ctx); got != "t0k3n" {
+		t.Errorf("expected token t0k3n, got %q", got)
+	}
+	if got := CSRFFieldNameFrom(ctx); got != "gorilla.csrf.Token" {
+		t.Errorf("expected field name gorilla.csrf.Token, got %q", got)
+	}
+}
+
+func TestCSRFMiddleware(t *testing.T) {
+	var got, name string
+	handler := CSRFMiddleware(func(r *http.Request) string {
+		return r.Header.Get("X-Token")
+	}, "gorilla.csrf.Token")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = CSRFToken(r.Context())
+		name = CSRFFieldNameFrom(r.Context())
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("X-Token", "t0k3n")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	if got != "t0k3n" {
+		t.Errorf("expected token t0k3n in context, got %q", got)
+	}
+	if name != "gorilla.csrf.Token" {
+		t.Errorf("expected field name gorilla.csrf.Token in context, got %q", name)
+	}
+}
+
+func TestIsRelativeURL(t *testing.T) {
+	tests := []struct {
+		action string
+		expect bool
+	}{
+		{action: "", expect: true},
+		{action: "/users", expect: true},
+		{action: "save?next=https://example.com", expect: true},
+		{action: "https://example.com/users", expect: false},
+		{action: "//example.com/users", expect: false},
+		{action: `/\example.com`, expect: false},
+		{action: "javascript:alert(1)", expect: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.action, func(t *testing.T) {
+			if got := isRelativeURL(tt.action); got != tt.expect {
+				t.Errorf("expected %v, got %v", tt.expect, got)
+			}
+		})
+	}
+}
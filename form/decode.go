@@ -0,0 +1,222 @@
+package form
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxMemory is the maximum number of bytes of a multipart form kept in
+// memory by Decode, the rest being stored in temporary files.
+const MaxMemory = 32 << 20
+
+// Decode populates the struct pointed to by dst from the request's
+// posted form values.
+//
+// Fields are matched by the same form struct tags used by FromStruct,
+// so a generated form decodes back into the struct it was rendered
+// from. Checkbox groups and multi-selects fill slices, booleans are set
+// when their checkbox is submitted, numbers, dates and times are parsed
+// according to the field's type tag, and types implementing
+// encoding.TextUnmarshaler decode themselves. Empty values set the zero
+// value (or nil for pointers).
+//
+// Boolean and slice fields are always reset, to false and nil, when the
+// form holds no value for them, because browsers submit nothing for an
+// unchecked checkbox or a multi-select without a selection. Other fields
+// absent from the form and fields tagged disabled are left untouched, so
+// don't decode a form that only renders some of the struct's boolean or
+// slice fields into a struct whose other values must be kept.
+//
+// Values that can't be parsed are reported in the returned Errors,
+// keyed by field name, while all other fields are still decoded, so the
+// form can be re-rendered with FromStruct and the submitted values in
+// one round trip. The error is only set when dst is not a non-nil
+// pointer to a struct or the request body can't be parsed.
+func Decode(r *http.Request, dst any) (Errors, error) {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return nil, errors.New("form: Decode requires a non-nil pointer to a struct")
+	}
+	if err := parseForm(r); err != nil {
+		return nil, err
+	}
+
+	var errs Errors
+	for _, sf := range structFields(rv.Elem().Type()) {
+		if sf.Tag.Disabled {
+			continue
+		}
+		values, ok := r.PostForm[sf.Tag.Name]
+		isSlice := sf.Type.Kind() == reflect.Slice
+		// Unchecked checkboxes and empty multi-selects aren't submitted, so
+		// booleans and slices are reset even when absent
+		if !ok && !isSlice && sf.Type.Kind() != reflect.Bool {
+			continue
+		}
+		fv := fieldByIndexAlloc(rv.Elem(), sf.Index)
+		if err := setField(fv, values, sf.Tag.Type); err != nil {
+			if errs == nil {
+				errs = Errors{}
+			}
+			errs.Add(sf.Tag.Name, err.Error())
+		}
+	}
+	return errs, nil
+}
+
+// Helper function to parse url-encoded or multipart request bodies
+func parseForm(r *http.Request) error {
+	if r.PostForm != nil {
+		return nil
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "multipart/form-data" {
+		return r.ParseMultipartForm(MaxMemory)
+	}
+	return r.ParseForm()
+}
+
+// Helper function to return a nested field, allocating nil embedded struct pointers
+func fieldByIndexAlloc(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// Helper function to set a field from its submitted values
+func setField(fv reflect.Value, values []string, typ string) error {
+	if fv.Kind() == reflect.Pointer {
+		if len(values) == 0 || values[0] == "" {
+			fv.SetZero()
+			return nil
+		}
+		if fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+		fv = fv.Elem()
+	}
+
+	if fv.Kind() == reflect.Slice {
+		if len(values) == 0 {
+			fv.SetZero()
+			return nil
+		}
+		slice := reflect.MakeSlice(fv.Type(), 0, len(values))
+		var firstErr error
+		for _, s := range values {
+			elem := reflect.New(fv.Type().Elem()).Elem()
+			if err := parseValue(elem, s, typ); err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+			slice = reflect.Append(slice, elem)
+		}
+		fv.Set(slice)
+		return firstErr
+	}
+
+	if fv.Kind() == reflect.Bool {
+		fv.SetBool(len(values) > 0 && isTruthy(values[len(values)-1]))
+		return nil
+	}
+
+	var s string
+	if len(values) > 0 {
+		s = values[0]
+	}
+	return parseValue(fv, s, typ)
+}
+
+// Helper function to parse a single submitted value into v
+func parseValue(v reflect.Value, s string, typ string) error {
+	if v.Type() == timeType {
+		if s == "" {
+			v.SetZero()
+			return nil
+		}
+		t, err := time.ParseInLocation(timeLayout(typ), s, time.Local)
+		if err != nil {
+			return errors.New("must be a valid " + timeDescription(typ))
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+	if u, ok := v.Addr().Interface().(interface{ UnmarshalText([]byte) error }); ok {
+		if err := u.UnmarshalText([]byte(s)); err != nil {
+			return errors.New("is invalid")
+		}
+		return nil
+	}
+
+	if v.Kind() == reflect.String {
+		v.SetString(s)
+		return nil
+	}
+	s = strings.TrimSpace(s)
+	if s == "" {
+		v.SetZero()
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(isTruthy(s))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return errors.New("must be a whole number")
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return errors.New("must be a positive whole number")
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return errors.New("must be a number")
+		}
+		v.SetFloat(n)
+	default:
+		return errors.New("is not supported")
+	}
+	return nil
+}
+
+// Helper function to report whether a checkbox value means checked
+func isTruthy(s string) bool {
+	switch strings.ToLower(s) {
+	case "", "0", "false", "off", "no":
+		return false
+	}
+	return true
+}
+
+// Helper function to describe a date or time input type in error messages
+func timeDescription(typ string) string {
+	switch typ {
+	case "datetime-local":
+		return "date and time"
+	case "time":
+		return "time"
+	case "month":
+		return "month"
+	default:
+		return "date"
+	}
+}
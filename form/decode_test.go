@@ -0,0 +1,221 @@
+package form
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+type level int
+
+func (l *level) UnmarshalText(b []byte) error {
+	switch string(b) {
+	case "low":
+		*l = 1
+	case "high":
+		*l = 2
+	default:
+		return strconv.ErrSyntax
+	}
+	return nil
+}
+
+type decodeTarget struct {
+	Name      string
+	Password  string   `form:"type=password"`
+	Age       int      `form:"type=number"`
+	Score     *float64 `form:"type=number"`
+	Admin     bool
+	Tags      []string  `form:"options=go|templ"`
+	IDs       []int     `form:"name=ids,type=select"`
+	Born      time.Time `form:"type=date"`
+	Starts    time.Time `form:"type=datetime-local"`
+	Level     level
+	Locked    string `form:"disabled"`
+	Untouched string
+	Skipped   string `form:"-"`
+}
+
+func postForm(values url.Values) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+func TestDecode(t *testing.T) {
+	score := 9.5
+	tests := []struct {
+		name       string
+		values     url.Values
+		start      decodeTarget
+		expect     decodeTarget
+		expectErrs Errors
+	}{
+		{
+			name: "All field kinds",
+			values: url.Values{
+				"name":     {"Jane"},
+				"password": {" secret "},
+				"age":      {"42"},
+				"score":    {"9.5"},
+				"admin":    {"true"},
+				"tags":     {"go", "templ"},
+				"ids":      {"1", "3"},
+				"born":     {"2000-01-02"},
+				"starts":   {"2025-03-01T18:30"},
+				"level":    {"high"},
+				"locked":   {"changed"},
+				"skipped":  {"changed"},
+			},
+			start: decodeTarget{Locked: "original", Untouched: "kept"},
+			expect: decodeTarget{
+				Name:      "Jane",
+				Password:  " secret ",
+				Age:       42,
+				Score:     &score,
+				Admin:     true,
+				Tags:      []string{"go", "templ"},
+				IDs:       []int{1, 3},
+				Born:      time.Date(2000, 1, 2, 0, 0, 0, 0, time.Local),
+				Starts:    time.Date(2025, 3, 1, 18, 30, 0, 0, time.Local),
+				Level:     2,
+				Locked:    "original",
+				Untouched: "kept",
+			},
+		},
+		{
+			name:   "Empty and unchecked values",
+			values: url.Values{"age": {""}, "score": {""}, "born": {""}},
+			start: decodeTarget{
+				Age:   7,
+				Score: &score,
+				Admin: true,
+				Tags:  []string{"go"},
+				Born:  time.Now(),
+			},
+			expect: decodeTarget{},
+		},
+		{
+			name:   "Absent fields",
+			values: url.Values{"name": {"Jane"}},
+			start: decodeTarget{
+				Age:   7,
+				Admin: true,
+				Tags:  []string{"go"},
+			},
+			expect: decodeTarget{Name: "Jane", Age: 7},
+		},
+		{
+			name: "Parse failures",
+			values: url.Values{
+				"name":  {"Jane"},
+				"age":   {"forty"},
+				"ids":   {"1", "x"},
+				"born":  {"02/01/2000"},
+				"level": {"medium"},
+			},
+			expect: decodeTarget{Name: "Jane", IDs: []int{1}},
+			expectErrs: Errors{
+				"age":   {"must be a whole number"},
+				"ids":   {"must be a whole number"},
+				"born":  {"must be a valid date"},
+				"level": {"is invalid"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.start
+			errs, err := Decode(postForm(tt.values), &got)
+			if err != nil {
+				t.Fatalf("decode failed: %v", err)
+			}
+			if !reflect.DeepEqual(errs, tt.expectErrs) {
+				t.Errorf("expected errors %v, got %v", tt.expectErrs, errs)
+			}
+			if !reflect.DeepEqual(got, tt.expect) {
+				t.Errorf("expected:\n%+v\ngot:\n%+v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestDecodeMultipart(t *testing.T) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	_ = mw.WriteField("name", "Jane")
+	_ = mw.WriteField("tags", "go")
+	_ = mw.WriteField("tags", "templ")
+	_ = mw.Close()
+	r := httptest.NewRequest(http.MethodPost, "/", &body)
+	r.Header.Set("Content-Type", mw.FormDataContentType())
+
+	var got decodeTarget
+	if _, err := Decode(r, &got); err != nil {
+		t.Fatalf("decode failed: %v", err)
+	}
+	if got.Name != "Jane" || !reflect.DeepEqual(got.Tags, []string{"go", "templ"}) {
+		t.Errorf("unexpected result %+v", got)
+	}
+}
+
+func TestDecodeInvalidDestination(t *testing.T) {
+	r := postForm(url.Values{})
+	for _, dst := range []any{nil, decodeTarget{}, new(string), (*decodeTarget)(nil)} {
+		if _, err := Decode(r, dst); err == nil {
+			t.Errorf("expected an error for %T", dst)
+		}
+	}
+}
+
+func TestDecodeRoundTrip(t *testing.T) {
+	type Signup struct {
+		Email string `form:"type=email"`
+		Age   int
+	}
+	values := url.Values{"email": {"jane@example.com"}, "age": {"forty"}}
+	var s Signup
+	errs, err := Decode(postForm(values), &s)
+	if err != nil {
+		t.Fatalf("decode failed: %v", err)
+	}
+
+	var buf strings.Builder
+	opts := FromStructOptions{Values: values, Errors: errs}
+	if err := FromStruct(s, opts).Render(context.Background(), &buf); err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	got := buf.String()
+	for _, want := range []string{`value="jane@example.com"`, `value="forty"`, `<p id="age-error" class="help is-danger">must be a whole number </p>`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected output to contain %s, got:\n%s", want, got)
+		}
+	}
+}
+
+type decodeAudit struct {
+	CreatedBy string
+}
+
+func TestDecodeUnexportedEmbedded(t *testing.T) {
+	var dst struct {
+		decodeAudit
+		Name string
+	}
+	errs, err := Decode(postForm(url.Values{"created_by": {"alex"}, "name": {"Ada"}}), &dst)
+	if err != nil || len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v, %v", err, errs)
+	}
+	if dst.CreatedBy != "alex" || dst.Name != "Ada" {
+		t.Errorf("expected promoted field to be decoded, got %+v", dst)
+	}
+}
@@ -0,0 +1,115 @@
+package form
+
+import (
+	"errors"
+	"reflect"
+)
+
+// Errors maps form field names to their validation messages.
+//
+// Errors can be passed directly as the Errors prop of input, textarea,
+// select, checkbox and radio components, which look up the messages of
+// their field by name, mark the control as invalid and render the
+// messages underneath. Messages that don't belong to a single field are
+// stored under the empty name.
+type Errors map[string][]string
+
+// FieldError is implemented by validation errors bound to a single field,
+// such as go-playground/validator's FieldError.
+type FieldError interface {
+	error
+
+	// Field returns the name of the invalid field
+	Field() string
+}
+
+// ErrorsFromMap builds Errors from a map of field names to single messages.
+func ErrorsFromMap(m map[string]string) Errors {
+	errs := make(Errors, len(m))
+	for field, msg := range m {
+		errs.Add(field, msg)
+	}
+	return errs
+}
+
+// ErrorsFromFieldErrors builds Errors from field errors, e.g. from the
+// validator.ValidationErrors returned by go-playground/validator. Field
+// names are used as reported, so register a tag name function with the
+// validator to report form field names rather than Go field names.
+func ErrorsFromFieldErrors[E FieldError](fieldErrors []E) Errors {
+	errs := make(Errors, len(fieldErrors))
+	for _, fe := range fieldErrors {
+		errs.Add(fe.Field(), fe.Error())
+	}
+	return errs
+}
+
+// ErrorsFromError builds Errors from an error, following errors created
+// with errors.Join or fmt.Errorf with several %w verbs. FieldError values,
+// and slices of them, are keyed by their field; any other error is stored
+// under the empty name. A nil error returns nil.
+func ErrorsFromError(err error) Errors {
+	if err == nil {
+		return nil
+	}
+	errs := Errors{}
+	errs.addError(err)
+	return errs
+}
+
+// Add appends a message to the given field.
+func (e Errors) Add(field, msg string) {
+	e[field] = append(e[field], msg)
+}
+
+// Get returns the messages of the given field.
+func (e Errors) Get(field string) []string {
+	return e[field]
+}
+
+// Has reports whether the given field has any messages.
+func (e Errors) Has(field string) bool {
+	return len(e[field]) > 0
+}
+
+// Helper function to add an error, unwrapping joined errors and field error slices
+func (e Errors) addError(err error) {
+	if isMulti(err) {
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, inner := range joined.Unwrap() {
+				e.addError(inner)
+			}
+			return
+		}
+		v := reflect.ValueOf(err)
+		for i := range v.Len() {
+			if inner, ok := v.Index(i).Interface().(error); ok {
+				e.addError(inner)
+			}
+		}
+		return
+	}
+	for inner := errors.Unwrap(err); inner != nil; inner = errors.Unwrap(inner) {
+		if isMulti(inner) {
+			e.addError(inner)
+			return
+		}
+		if _, ok := inner.(FieldError); ok {
+			break
+		}
+	}
+	var fe FieldError
+	if errors.As(err, &fe) {
+		e.Add(fe.Field(), err.Error())
+		return
+	}
+	e.Add("", err.Error())
+}
+
+// Helper function to report whether an error holds several errors
+func isMulti(err error) bool {
+	if _, ok := err.(interface{ Unwrap() []error }); ok {
+		return true
+	}
+	return reflect.ValueOf(err).Kind() == reflect.Slice
+}
@@ -0,0 +1,108 @@
+package form
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type fieldError struct {
+	field string
+	msg   string
+}
+
+func (e fieldError) Field() string { return e.field }
+func (e fieldError) Error() string { return e.msg }
+
+// validationErrors mimics validator.ValidationErrors
+type validationErrors []FieldError
+
+func (v validationErrors) Error() string { return "validation failed" }
+
+func TestErrorsFromMap(t *testing.T) {
+	got := ErrorsFromMap(map[string]string{"email": "is required"})
+	expect := Errors{"email": {"is required"}}
+	if !reflect.DeepEqual(got, expect) {
+		t.Errorf("expected %v, got %v", expect, got)
+	}
+}
+
+func TestErrorsFromFieldErrors(t *testing.T) {
+	errs := validationErrors{
+		fieldError{field: "email", msg: "is required"},
+		fieldError{field: "email", msg: "is invalid"},
+		fieldError{field: "age", msg: "must be positive"},
+	}
+	got := ErrorsFromFieldErrors(errs)
+	expect := Errors{
+		"email": {"is required", "is invalid"},
+		"age":   {"must be positive"},
+	}
+	if !reflect.DeepEqual(got, expect) {
+		t.Errorf("expected %v, got %v", expect, got)
+	}
+}
+
+func TestErrorsFromError(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		expect Errors
+	}{
+		{
+			name:   "Nil",
+			err:    nil,
+			expect: nil,
+		},
+		{
+			name:   "Plain error",
+			err:    errors.New("something went wrong"),
+			expect: Errors{"": {"something went wrong"}},
+		},
+		{
+			name:   "Joined errors",
+			err:    errors.Join(fieldError{field: "name", msg: "is required"}, errors.New("try again")),
+			expect: Errors{"name": {"is required"}, "": {"try again"}},
+		},
+		{
+			name:   "Wrapped field error",
+			err:    fmt.Errorf("name: %w", fieldError{field: "name", msg: "is required"}),
+			expect: Errors{"name": {"name: is required"}},
+		},
+		{
+			name: "Wrapped field error slice",
+			err: fmt.Errorf("validate: %w", validationErrors{
+				fieldError{field: "email", msg: "is invalid"},
+			}),
+			expect: Errors{"email": {"is invalid"}},
+		},
+		{
+			name: "Doubly wrapped field error slice",
+			err: fmt.Errorf("signup: %w", fmt.Errorf("validate: %w", validationErrors{
+				fieldError{field: "email", msg: "is invalid"},
+			})),
+			expect: Errors{"email": {"is invalid"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ErrorsFromError(tt.err)
+			if !reflect.DeepEqual(got, tt.expect) {
+				t.Errorf("expected %v, got %v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestErrorsAccessors(t *testing.T) {
+	errs := Errors{}
+	errs.Add("email", "is required")
+	if !errs.Has("email") || errs.Has("name") {
+		t.Errorf("unexpected Has results for %v", errs)
+	}
+	if got := errs.Get("email"); !reflect.DeepEqual(got, []string{"is required"}) {
+		t.Errorf("unexpected Get result %v", got)
+	}
+}
@@ -0,0 +1,474 @@
+// Code generated by templ - DO NOT EDIT.
+
+// templ: version: v0.3.943
+package file
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import templruntime "github.com/a-h/templ/runtime"
+
+import (
+	"strings"
+
+	"github.com/alexferl/templaui/internal/csp"
+)
+
+// DropzoneProps defines configuration for drag-and-drop file upload areas.
+//
+// Use this type to configure boxed file uploads that also accept files
+// dropped onto them, preview image thumbnails and list the selected
+// files with remove buttons. Files are always submitted through a real
+// file input, so the dropzone degrades to a plain boxed file upload
+// without JavaScript.
+type DropzoneProps struct {
+	// Optional HTML id attribute for the dropzone, also used to derive the input id
+	ID string
+
+	// List of additional CSS classes to apply to the dropzone
+	Class []string
+
+	// Additional arbitrary HTML attributes
+	Attributes templ.Attributes
+
+	// Name attribute of the file input for form submission
+	Name string
+
+	// Accept attribute for file type filtering (e.g., "image/*", ".pdf")
+	Accept string
+
+	// Enable multiple file selection; dropped files are added to the selection
+	Multiple bool
+
+	// Disable file selection and dropping
+	Disabled bool
+
+	// Mark file input as required for form validation
+	Required bool
+
+	// Maximum size of each selected file in bytes, checked by FileScript
+	MaxSize int64
+
+	// Error shown by FileScript when a file exceeds MaxSize; {name} and {max} are replaced
+	MaxSizeMessage string
+
+	// Error shown by FileScript when a file doesn't match Accept; {name} is replaced
+	AcceptMessage string
+
+	// Call-to-action text (defaults to "Choose or drop files…")
+	Label string
+
+	// Optional icon rendered above the call-to-action text
+	Icon templ.Component
+
+	// Accessible label prefix of the remove buttons (defaults to "Remove")
+	RemoveLabel string
+
+	// Dropzone size (small, normal, medium, large)
+	Size Size
+
+	// Dropzone color theme
+	Color Color
+}
+
+// Dropzone renders drag-and-drop file upload areas.
+//
+// This component renders a .dropzone wrapper around a boxed, full width
+// File built from FileLabel, FileInput, FileCTA, FileIcon and FileText,
+// followed by a .dropzone-files list. DropzoneScript, included once per
+// request along with DropzoneStyle and FileScript, adds dropped files to
+// the input, highlights the area while files are dragged over it, and
+// lists the selected files with image thumbnails and remove buttons,
+// while FileScript validates Accept and MaxSize on the client.
+func Dropzone(props ...DropzoneProps) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var1 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var1 == nil {
+			templ_7745c5c3_Var1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		var p DropzoneProps
+		if len(props) > 0 {
+			p = props[0]
+		}
+		templ_7745c5c3_Err = DropzoneStyle().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = FileScript().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = DropzoneScript().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var2 = []any{"dropzone",
+			templ.KV(strings.Join(p.Class, " "), len(p.Class) > 0),
+		}
+		templ_7745c5c3_Err = templ.RenderCSSItems(ctx, templ_7745c5c3_Buffer, templ_7745c5c3_Var2...)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if p.ID != "" {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 2, " id=\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var3 string
+			templ_7745c5c3_Var3, templ_7745c5c3_Err = templ.JoinStringErrs(p.ID)
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `form/file/dropzone.templ`, Line: 85, Col: 12}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var3))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 3, "\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 4, " class=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var4 string
+		templ_7745c5c3_Var4, templ_7745c5c3_Err = templ.JoinStringErrs(templ.CSSClasses(templ_7745c5c3_Var2).String())
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `form/file/dropzone.templ`, Line: 1, Col: 0}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var4))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 5, "\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if p.RemoveLabel != "" {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 6, " data-remove-label=\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var5 string
+			templ_7745c5c3_Var5, templ_7745c5c3_Err = templ.JoinStringErrs(p.RemoveLabel)
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `form/file/dropzone.templ`, Line: 92, Col: 36}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var5))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 7, "\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = templ.RenderAttributes(ctx, templ_7745c5c3_Buffer, p.Attributes)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 8, ">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Var6 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+			templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+			if !templ_7745c5c3_IsBuffer {
+				defer func() {
+					templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+					if templ_7745c5c3_Err == nil {
+						templ_7745c5c3_Err = templ_7745c5c3_BufErr
+					}
+				}()
+			}
+			ctx = templ.InitializeContext(ctx)
+			templ_7745c5c3_Var7 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+				templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+				templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+				if !templ_7745c5c3_IsBuffer {
+					defer func() {
+						templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+						if templ_7745c5c3_Err == nil {
+							templ_7745c5c3_Err = templ_7745c5c3_BufErr
+						}
+					}()
+				}
+				ctx = templ.InitializeContext(ctx)
+				templ_7745c5c3_Err = FileInput(FileInputProps{
+					ID:             dropzoneInputID(p.ID),
+					Name:           p.Name,
+					Accept:         p.Accept,
+					Multiple:       p.Multiple,
+					Disabled:       p.Disabled,
+					Required:       p.Required,
+					MaxSize:        p.MaxSize,
+					MaxSizeMessage: p.MaxSizeMessage,
+					AcceptMessage:  p.AcceptMessage,
+				}).Render(ctx, templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 9, " ")
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				templ_7745c5c3_Var8 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+					templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+					templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+					if !templ_7745c5c3_IsBuffer {
+						defer func() {
+							templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+							if templ_7745c5c3_Err == nil {
+								templ_7745c5c3_Err = templ_7745c5c3_BufErr
+							}
+						}()
+					}
+					ctx = templ.InitializeContext(ctx)
+					if p.Icon != nil {
+						templ_7745c5c3_Var9 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+							templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+							templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+							if !templ_7745c5c3_IsBuffer {
+								defer func() {
+									templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+									if templ_7745c5c3_Err == nil {
+										templ_7745c5c3_Err = templ_7745c5c3_BufErr
+									}
+								}()
+							}
+							ctx = templ.InitializeContext(ctx)
+							templ_7745c5c3_Err = p.Icon.Render(ctx, templ_7745c5c3_Buffer)
+							if templ_7745c5c3_Err != nil {
+								return templ_7745c5c3_Err
+							}
+							return nil
+						})
+						templ_7745c5c3_Err = FileIcon().Render(templ.WithChildren(ctx, templ_7745c5c3_Var9), templ_7745c5c3_Buffer)
+						if templ_7745c5c3_Err != nil {
+							return templ_7745c5c3_Err
+						}
+					}
+					templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 10, " ")
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+					templ_7745c5c3_Var10 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+						templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+						templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+						if !templ_7745c5c3_IsBuffer {
+							defer func() {
+								templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+								if templ_7745c5c3_Err == nil {
+									templ_7745c5c3_Err = templ_7745c5c3_BufErr
+								}
+							}()
+						}
+						ctx = templ.InitializeContext(ctx)
+						var templ_7745c5c3_Var11 string
+						templ_7745c5c3_Var11, templ_7745c5c3_Err = templ.JoinStringErrs(dropzoneLabel(p.Label))
+						if templ_7745c5c3_Err != nil {
+							return templ.Error{Err: templ_7745c5c3_Err, FileName: `form/file/dropzone.templ`, Line: 116, Col: 30}
+						}
+						_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var11))
+						if templ_7745c5c3_Err != nil {
+							return templ_7745c5c3_Err
+						}
+						return nil
+					})
+					templ_7745c5c3_Err = FileText().Render(templ.WithChildren(ctx, templ_7745c5c3_Var10), templ_7745c5c3_Buffer)
+					if templ_7745c5c3_Err != nil {
+						return templ_7745c5c3_Err
+					}
+					return nil
+				})
+				templ_7745c5c3_Err = FileCTA().Render(templ.WithChildren(ctx, templ_7745c5c3_Var8), templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err != nil {
+					return templ_7745c5c3_Err
+				}
+				return nil
+			})
+			templ_7745c5c3_Err = FileLabel().Render(templ.WithChildren(ctx, templ_7745c5c3_Var7), templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			return nil
+		})
+		templ_7745c5c3_Err = File(FileProps{IsBoxed: true, IsFullwidth: true, Size: p.Size, Color: p.Color}).Render(templ.WithChildren(ctx, templ_7745c5c3_Var6), templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 11, "<ul class=\"dropzone-files\" aria-live=\"polite\"></ul></div>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+// Helper function to return the id of a dropzone's file input
+func dropzoneInputID(id string) string {
+	if id == "" {
+		return ""
+	}
+	return id + "-input"
+}
+
+// Helper function to return the call-to-action text of a dropzone
+func dropzoneLabel(label string) string {
+	if label == "" {
+		return "Choose or drop files…"
+	}
+	return label
+}
+
+var dropzoneStyleHandle = templ.NewOnceHandle()
+
+// DropzoneStyle renders the stylesheet of Dropzone components.
+//
+// The styles are rendered once per request, so Dropzone includes them
+// automatically; render DropzoneStyle in the document head to load
+// them before the first dropzone. The style element carries the
+// request's CSP nonce when one is set with templ.WithNonce.
+func DropzoneStyle() templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var12 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var12 == nil {
+			templ_7745c5c3_Var12 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Var13 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+			templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+			if !templ_7745c5c3_IsBuffer {
+				defer func() {
+					templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+					if templ_7745c5c3_Err == nil {
+						templ_7745c5c3_Err = templ_7745c5c3_BufErr
+					}
+				}()
+			}
+			ctx = templ.InitializeContext(ctx)
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 12, "<style")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templ.RenderAttributes(ctx, templ_7745c5c3_Buffer, csp.NonceAttrs(ctx))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 13, ">\n\t\t\t.dropzone .file-label {\n\t\t\t\twidth: 100%;\n\t\t\t}\n\t\t\t.dropzone .file-cta {\n\t\t\t\twidth: 100%;\n\t\t\t\tpadding: 2em 1em;\n\t\t\t\tborder-style: dashed;\n\t\t\t\tborder-width: 2px;\n\t\t\t\ttransition: background-color 86ms ease-out, border-color 86ms ease-out;\n\t\t\t}\n\t\t\t.dropzone.is-dragover .file-cta {\n\t\t\t\tborder-color: var(--bulma-link, hsl(233, 100%, 63%));\n\t\t\t\tbackground-color: var(--bulma-scheme-main-ter, hsl(0, 0%, 96%));\n\t\t\t}\n\t\t\t.dropzone-files {\n\t\t\t\tmargin: 0.75em 0 0;\n\t\t\t\tpadding: 0;\n\t\t\t\tlist-style: none;\n\t\t\t}\n\t\t\t.dropzone-files:empty {\n\t\t\t\tdisplay: none;\n\t\t\t}\n\t\t\t.dropzone-file {\n\t\t\t\tdisplay: flex;\n\t\t\t\talign-items: center;\n\t\t\t\tgap: 0.75em;\n\t\t\t\tpadding: 0.375em 0;\n\t\t\t}\n\t\t\t.dropzone-file img {\n\t\t\t\twidth: 3em;\n\t\t\t\theight: 3em;\n\t\t\t\tobject-fit: cover;\n\t\t\t\tborder-radius: var(--bulma-radius-small, 0.25em);\n\t\t\t}\n\t\t\t.dropzone-file-name {\n\t\t\t\tflex: 1;\n\t\t\t\tmin-width: 0;\n\t\t\t\toverflow: hidden;\n\t\t\t\ttext-overflow: ellipsis;\n\t\t\t\twhite-space: nowrap;\n\t\t\t}\n\t\t\t.dropzone-file-size {\n\t\t\t\tcolor: var(--bulma-text-weak, hsl(0, 0%, 48%));\n\t\t\t\tfont-size: 0.875em;\n\t\t\t}\n\t\t</style>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			return nil
+		})
+		templ_7745c5c3_Err = dropzoneStyleHandle.Once().Render(templ.WithChildren(ctx, templ_7745c5c3_Var13), templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+var dropzoneScriptHandle = templ.NewOnceHandle()
+
+// DropzoneScript renders the script enabling Dropzone components.
+//
+// The script accepts files dropped on a .dropzone into its file input,
+// appending them when the input allows multiple files, and renders the
+// selection in the .dropzone-files list with image thumbnails and
+// remove buttons. Selections change through the input, so its change
+// event fires and FileScript validation applies to dropped files too.
+// The script is rendered once per request and included by Dropzone; it
+// carries the request's CSP nonce when one is set with templ.WithNonce.
+func DropzoneScript() templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var14 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var14 == nil {
+			templ_7745c5c3_Var14 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Var15 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+			templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+			if !templ_7745c5c3_IsBuffer {
+				defer func() {
+					templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+					if templ_7745c5c3_Err == nil {
+						templ_7745c5c3_Err = templ_7745c5c3_BufErr
+					}
+				}()
+			}
+			ctx = templ.InitializeContext(ctx)
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 14, "<script")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templ.RenderAttributes(ctx, templ_7745c5c3_Buffer, csp.NonceAttrs(ctx))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 15, ">\n\t\t\t(() => {\n\t\t\t\tconst formatSize = (bytes) => {\n\t\t\t\t\tconst units = [\"B\", \"KB\", \"MB\", \"GB\"];\n\t\t\t\t\tlet i = 0;\n\t\t\t\t\twhile (bytes >= 1024 && i < units.length - 1) {\n\t\t\t\t\t\tbytes /= 1024;\n\t\t\t\t\t\ti++;\n\t\t\t\t\t}\n\t\t\t\t\treturn `${Math.round(bytes * 10) / 10} ${units[i]}`;\n\t\t\t\t};\n\t\t\t\tconst previews = new WeakMap();\n\t\t\t\tconst inputOf = (zone) => zone.querySelector(\"input.file-input\");\n\t\t\t\tconst setFiles = (input, files) => {\n\t\t\t\t\tconst transfer = new DataTransfer();\n\t\t\t\t\tfiles.forEach((file) => transfer.items.add(file));\n\t\t\t\t\tinput.files = transfer.files;\n\t\t\t\t\tinput.dispatchEvent(new Event(\"change\", { bubbles: true }));\n\t\t\t\t};\n\t\t\t\tconst render = (zone) => {\n\t\t\t\t\tconst input = inputOf(zone);\n\t\t\t\t\tconst list = zone.querySelector(\".dropzone-files\");\n\t\t\t\t\tif (!input || !list) return;\n\t\t\t\t\t(previews.get(zone) || []).forEach((url) => URL.revokeObjectURL(url));\n\t\t\t\t\tconst urls = [];\n\t\t\t\t\tlist.replaceChildren(...Array.from(input.files, (file, index) => {\n\t\t\t\t\t\tconst item = document.createElement(\"li\");\n\t\t\t\t\t\titem.className = \"dropzone-file\";\n\t\t\t\t\t\tif (file.type.startsWith(\"image/\")) {\n\t\t\t\t\t\t\tconst img = document.createElement(\"img\");\n\t\t\t\t\t\t\timg.src = URL.createObjectURL(file);\n\t\t\t\t\t\t\timg.alt = \"\";\n\t\t\t\t\t\t\turls.push(img.src);\n\t\t\t\t\t\t\titem.append(img);\n\t\t\t\t\t\t}\n\t\t\t\t\t\tconst name = document.createElement(\"span\");\n\t\t\t\t\t\tname.className = \"dropzone-file-name\";\n\t\t\t\t\t\tname.textContent = file.name;\n\t\t\t\t\t\tconst size = document.createElement(\"span\");\n\t\t\t\t\t\tsize.className = \"dropzone-file-size\";\n\t\t\t\t\t\tsize.textContent = formatSize(file.size);\n\t\t\t\t\t\tconst remove = document.createElement(\"button\");\n\t\t\t\t\t\tremove.type = \"button\";\n\t\t\t\t\t\tremove.className = \"delete\";\n\t\t\t\t\t\tremove.dataset.dropzoneRemove = index;\n\t\t\t\t\t\tremove.setAttribute(\"aria-label\", `${zone.dataset.removeLabel || \"Remove\"} ${file.name}`);\n\t\t\t\t\t\tremove.disabled = input.disabled;\n\t\t\t\t\t\titem.append(name, size, remove);\n\t\t\t\t\t\treturn item;\n\t\t\t\t\t}));\n\t\t\t\t\tpreviews.set(zone, urls);\n\t\t\t\t};\n\t\t\t\tconst zoneOf = (event) => event.target instanceof Element ? event.target.closest(\".dropzone\") : null;\n\t\t\t\t[\"dragenter\", \"dragover\"].forEach((type) => document.addEventListener(type, (event) => {\n\t\t\t\t\tconst zone = zoneOf(event);\n\t\t\t\t\tif (!zone || !event.dataTransfer || !event.dataTransfer.types.includes(\"Files\")) return;\n\t\t\t\t\tevent.preventDefault();\n\t\t\t\t\tconst input = inputOf(zone);\n\t\t\t\t\tevent.dataTransfer.dropEffect = input && !input.disabled ? \"copy\" : \"none\";\n\t\t\t\t\tzone.classList.add(\"is-dragover\");\n\t\t\t\t}));\n\t\t\t\tdocument.addEventListener(\"dragleave\", (event) => {\n\t\t\t\t\tconst zone = zoneOf(event);\n\t\t\t\t\tif (zone && !zone.contains(event.relatedTarget)) zone.classList.remove(\"is-dragover\");\n\t\t\t\t});\n\t\t\t\tdocument.addEventListener(\"drop\", (event) => {\n\t\t\t\t\tconst zone = zoneOf(event);\n\t\t\t\t\tif (!zone) return;\n\t\t\t\t\tevent.preventDefault();\n\t\t\t\t\tzone.classList.remove(\"is-dragover\");\n\t\t\t\t\tconst input = inputOf(zone);\n\t\t\t\t\tconst dropped = Array.from(event.dataTransfer.files);\n\t\t\t\t\tif (!input || input.disabled || dropped.length === 0) return;\n\t\t\t\t\tsetFiles(input, input.multiple ? [...input.files, ...dropped] : dropped.slice(0, 1));\n\t\t\t\t});\n\t\t\t\tdocument.addEventListener(\"change\", (event) => {\n\t\t\t\t\tconst zone = zoneOf(event);\n\t\t\t\t\tif (zone && event.target.matches(\"input.file-input\")) render(zone);\n\t\t\t\t});\n\t\t\t\tdocument.addEventListener(\"click\", (event) => {\n\t\t\t\t\tconst remove = event.target instanceof Element ? event.target.closest(\"[data-dropzone-remove]\") : null;\n\t\t\t\t\tconst zone = remove && remove.closest(\".dropzone\");\n\t\t\t\t\tif (!zone) return;\n\t\t\t\t\tconst input = inputOf(zone);\n\t\t\t\t\tconst index = Number(remove.dataset.dropzoneRemove);\n\t\t\t\t\tsetFiles(input, Array.from(input.files).filter((_, i) => i !== index));\n\t\t\t\t\tinput.focus();\n\t\t\t\t});\n\t\t\t\tdocument.addEventListener(\"reset\", (event) => {\n\t\t\t\t\tsetTimeout(() => event.target.querySelectorAll(\".dropzone\").forEach(render));\n\t\t\t\t});\n\t\t\t})();\n\t\t</script>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			return nil
+		})
+		templ_7745c5c3_Err = dropzoneScriptHandle.Once().Render(templ.WithChildren(ctx, templ_7745c5c3_Var15), templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+var _ = templruntime.GeneratedTemplate
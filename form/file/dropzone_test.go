@@ -0,0 +1,126 @@
+package file
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestDropzone(t *testing.T) {
+	tests := []struct {
+		name   string
+		props  DropzoneProps
+		expect string
+	}{
+		{
+			name:   "Default",
+			props:  DropzoneProps{},
+			expect: `<div class="dropzone"><div class="file is-boxed is-fullwidth"><label class="file-label"><input type="file" class="file-input"> <span class="file-cta"> <span class="file-label">Choose or drop files…</span></span></label></div><ul class="dropzone-files" aria-live="polite"></ul></div>`,
+		},
+		{
+			name: "Input configuration",
+			props: DropzoneProps{
+				ID:             "photos",
+				Name:           "photos",
+				Accept:         "image/*",
+				Multiple:       true,
+				Required:       true,
+				MaxSize:        1 << 20,
+				MaxSizeMessage: "{name} is too big",
+				AcceptMessage:  "Images only",
+			},
+			expect: `<div id="photos" class="dropzone"><div class="file is-boxed is-fullwidth"><label class="file-label"><input id="photos-input" type="file" name="photos" accept="image/*" multiple required data-max-size="1048576" data-max-size-message="{name} is too big" data-accept-message="Images only" class="file-input"> <span class="file-cta"> <span class="file-label">Choose or drop files…</span></span></label></div><ul class="dropzone-files" aria-live="polite"></ul></div>`,
+		},
+		{
+			name:   "Disabled",
+			props:  DropzoneProps{Disabled: true},
+			expect: `<div class="dropzone"><div class="file is-boxed is-fullwidth"><label class="file-label"><input type="file" disabled class="file-input"> <span class="file-cta"> <span class="file-label">Choose or drop files…</span></span></label></div><ul class="dropzone-files" aria-live="polite"></ul></div>`,
+		},
+		{
+			name:   "Label, icon and remove label",
+			props:  DropzoneProps{Label: "Drop your resume", Icon: templ.Raw(`<i class="fas fa-upload"></i>`), RemoveLabel: "Supprimer"},
+			expect: `<div class="dropzone" data-remove-label="Supprimer"><div class="file is-boxed is-fullwidth"><label class="file-label"><input type="file" class="file-input"> <span class="file-cta"><span class="file-icon"><i class="fas fa-upload"></i></span> <span class="file-label">Drop your resume</span></span></label></div><ul class="dropzone-files" aria-live="polite"></ul></div>`,
+		},
+		{
+			name:   "Size and color",
+			props:  DropzoneProps{Size: IsLarge, Color: IsPrimary},
+			expect: `<div class="dropzone"><div class="file is-boxed is-fullwidth is-large is-primary"><label class="file-label"><input type="file" class="file-input"> <span class="file-cta"> <span class="file-label">Choose or drop files…</span></span></label></div><ul class="dropzone-files" aria-live="polite"></ul></div>`,
+		},
+		{
+			name:   "Custom class and attributes",
+			props:  DropzoneProps{Class: []string{"custom"}, Attributes: templ.Attributes{"data-test": "value"}},
+			expect: `<div class="dropzone custom" data-test="value"><div class="file is-boxed is-fullwidth"><label class="file-label"><input type="file" class="file-input"> <span class="file-cta"> <span class="file-label">Choose or drop files…</span></span></label></div><ul class="dropzone-files" aria-live="polite"></ul></div>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			ctx := templ.InitializeContext(context.Background())
+			// Render the shared stylesheet and script first so only the dropzone is compared
+			if err := DropzoneStyle().Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			if err := FileScript().Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			if err := DropzoneScript().Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			buf.Reset()
+			err := Dropzone(tt.props).Render(ctx, &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+
+	t.Run("Stylesheet and script rendered once with nonce", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.WithNonce(templ.InitializeContext(context.Background()), "r4nd0m")
+		for range 2 {
+			if err := Dropzone().Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+		}
+		got := buf.String()
+		if !strings.HasPrefix(got, `<style nonce="r4nd0m">`) {
+			t.Errorf("expected stylesheet with nonce, got: %s", got)
+		}
+		if n := strings.Count(got, "<style"); n != 1 {
+			t.Errorf("expected stylesheet to be rendered once, got %d", n)
+		}
+		if n := strings.Count(got, `<script nonce="r4nd0m">`); n != 2 {
+			t.Errorf("expected file and dropzone scripts to be rendered once with nonce, got %d", n)
+		}
+		if strings.Contains(got, "window.templaui") {
+			t.Errorf("expected scripts not to publish a global, got: %s", got)
+		}
+		if n := strings.Count(got, `class="dropzone"`); n != 2 {
+			t.Errorf("expected two dropzones, got %d", n)
+		}
+	})
+}
+
+func TestDropzoneScript(t *testing.T) {
+	var buf strings.Builder
+	err := DropzoneScript().Render(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	got := buf.String()
+	if !strings.HasPrefix(got, "<script>") {
+		t.Errorf("expected script without nonce, got: %s", got)
+	}
+	for _, s := range []string{`"drop"`, "DataTransfer", "data-dropzone-remove", "URL.createObjectURL", "const formatSize"} {
+		if !strings.Contains(got, strings.Trim(s, `"`)) {
+			t.Errorf("expected script to contain %s", s)
+		}
+	}
+}
@@ -181,6 +181,16 @@ func TestFileInput(t *testing.T) {
 			},
 			expect: `<input id="document-upload" type="file" name="documents" accept=".pdf,.doc,.docx" multiple required class="file-input custom-input">`,
 		},
+		{
+			name:   "With max size",
+			props:  FileInputProps{MaxSize: 5 << 20},
+			expect: `<input type="file" data-max-size="5242880" class="file-input">`,
+		},
+		{
+			name:   "With validation messages",
+			props:  FileInputProps{Accept: ".pdf", MaxSize: 1024, MaxSizeMessage: "{name} exceeds {max}", AcceptMessage: "Only PDF files"},
+			expect: `<input type="file" accept=".pdf" data-max-size="1024" data-max-size-message="{name} exceeds {max}" data-accept-message="Only PDF files" class="file-input">`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -313,6 +323,11 @@ func TestFileName(t *testing.T) {
 			props:  FileNameProps{ID: "test-name", Class: []string{"custom"}},
 			expect: `<span id="test-name" class="file-name custom"></span>`,
 		},
+		{
+			name:   "With count text",
+			props:  FileNameProps{CountText: "{count} fichiers"},
+			expect: `<span class="file-name" data-count-text="{count} fichiers"></span>`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -412,3 +427,45 @@ func TestFileAttributes(t *testing.T) {
 		}
 	}
 }
+
+func TestFileScript(t *testing.T) {
+	t.Run("Without nonce", func(t *testing.T) {
+		var buf strings.Builder
+		err := FileScript().Render(context.Background(), &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		got := buf.String()
+		if !strings.HasPrefix(got, "<script>") {
+			t.Errorf("expected script without nonce, got: %s", got)
+		}
+		if !strings.Contains(got, "input.file-input") {
+			t.Errorf("expected script to target file inputs, got: %s", got)
+		}
+	})
+
+	t.Run("With nonce", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.WithNonce(context.Background(), "r4nd0m")
+		err := FileScript().Render(ctx, &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		if !strings.HasPrefix(buf.String(), `<script nonce="r4nd0m">`) {
+			t.Errorf("expected script with nonce, got: %s", buf.String())
+		}
+	})
+
+	t.Run("Rendered once per context", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.InitializeContext(context.Background())
+		for range 2 {
+			if err := FileScript().Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+		}
+		if n := strings.Count(buf.String(), "<script"); n != 1 {
+			t.Errorf("expected script to be rendered once, got %d", n)
+		}
+	})
+}
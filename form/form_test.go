@@ -160,3 +160,117 @@ func TestFormCustomAttributes(t *testing.T) {
 		}
 	}
 }
+
+func TestFormCSRF(t *testing.T) {
+	tokenCtx := WithCSRFToken(context.Background(), "t0k3n", "")
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		props  FormProps
+		expect string
+	}{
+		{
+			name:   "Explicit token",
+			ctx:    context.Background(),
+			props:  FormProps{Method: "post", CSRFToken: "abc"},
+			expect: `<form method="post" class=""><input type="hidden" name="csrf_token" value="abc"></form>`,
+		},
+		{
+			name:   "Token from context",
+			ctx:    tokenCtx,
+			props:  FormProps{Action: "/users", Method: "post"},
+			expect: `<form action="/users" method="post" class=""><input type="hidden" name="csrf_token" value="t0k3n"></form>`,
+		},
+		{
+			name:   "Custom field name",
+			ctx:    tokenCtx,
+			props:  FormProps{Method: "post", CSRFFieldName: "gorilla.csrf.Token"},
+			expect: `<form method="post" class=""><input type="hidden" name="gorilla.csrf.Token" value="t0k3n"></form>`,
+		},
+		{
+			name:   "Field name from context",
+			ctx:    WithCSRFToken(context.Background(), "t0k3n", "gorilla.csrf.Token"),
+			props:  FormProps{Method: "post"},
+			expect: `<form method="post" class=""><input type="hidden" name="gorilla.csrf.Token" value="t0k3n"></form>`,
+		},
+		{
+			name:   "Context token skipped for GET forms",
+			ctx:    tokenCtx,
+			props:  FormProps{Action: "/search"},
+			expect: `<form action="/search" class=""></form>`,
+		},
+		{
+			name:   "Context token skipped for other origins",
+			ctx:    tokenCtx,
+			props:  FormProps{Action: "https://example.com/hook", Method: "post"},
+			expect: `<form action="https://example.com/hook" method="post" class=""></form>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := Form(tt.props).Render(tt.ctx, &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestCSRF(t *testing.T) {
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		token  string
+		props  CSRFProps
+		expect string
+	}{
+		{
+			name:   "Explicit token",
+			ctx:    context.Background(),
+			token:  "abc",
+			expect: `<input type="hidden" name="csrf_token" value="abc">`,
+		},
+		{
+			name:   "Token from context",
+			ctx:    WithCSRFToken(context.Background(), "t0k3n", ""),
+			expect: `<input type="hidden" name="csrf_token" value="t0k3n">`,
+		},
+		{
+			name:   "Field name from context",
+			ctx:    WithCSRFToken(context.Background(), "t0k3n", "_csrf"),
+			expect: `<input type="hidden" name="_csrf" value="t0k3n">`,
+		},
+		{
+			name:   "No token",
+			ctx:    context.Background(),
+			expect: ``,
+		},
+		{
+			name:   "All fields combined",
+			ctx:    context.Background(),
+			token:  "abc",
+			props:  CSRFProps{ID: "csrf", Name: "_csrf", Attributes: templ.Attributes{"data-test": "value"}},
+			expect: `<input id="csrf" type="hidden" name="_csrf" value="abc" data-test="value">`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := CSRF(tt.token, tt.props).Render(tt.ctx, &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
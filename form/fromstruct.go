@@ -0,0 +1,215 @@
+package form
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/a-h/templ"
+
+	"github.com/alexferl/templaui/form/selectbox"
+)
+
+// FromStructOptions configures forms generated by FromStruct.
+type FromStructOptions struct {
+	// Props applied to the generated form element
+	Form FormProps
+
+	// Prefix added to generated field IDs to keep them unique on the page
+	IDPrefix string
+
+	// Choices of select, radio and checkbox group fields by field name,
+	// overriding the options tag (useful for choices loaded at runtime)
+	Choices map[string][]Choice
+
+	// Submitted values (e.g. r.PostForm) overriding the struct's values,
+	// so input that failed to decode is rendered back as typed
+	Values url.Values
+
+	// Validation errors rendered with the fields; messages under the
+	// empty name are rendered above the fields
+	Errors Errors
+
+	// Text of the submit button (defaults to "Submit")
+	SubmitLabel string
+
+	// Custom content rendered instead of the submit button
+	Actions templ.Component
+}
+
+// formField is a struct field resolved for rendering.
+type formField struct {
+	fieldTag
+
+	// HTML id of the control
+	ID string
+
+	// Current value of single-valued fields
+	Value string
+
+	// Current values of multi-valued fields
+	Values []string
+
+	// Current state of boolean checkbox fields
+	Checked bool
+
+	// Field holds several values (checkbox group or multi-select)
+	Multiple bool
+}
+
+// FromStruct renders a complete form for a struct or pointer to struct.
+//
+// Every exported field becomes a Field with a Label, a Control and the
+// matching input, textarea, select, checkbox or radio component, filled
+// with the field's current value. Fields are configured with the form
+// struct tag, a comma-separated list of options:
+//
+//	type Signup struct {
+//		Email    string   `form:"label=Email,type=email,required,placeholder=you@example.com"`
+//		Bio      string   `form:"type=textarea,rows=4,help=Tell us about yourself"`
+//		Plan     string   `form:"type=radio,options=free:Free|pro:Pro"`
+//		Tags     []string `form:"options=go|templ|bulma"`
+//		Accept   bool     `form:"label=I accept the terms,required"`
+//		Internal string   `form:"-"`
+//	}
+//
+// Supported keys are name (defaults to the snake_case field name),
+// label (defaults to the humanized field name), type, placeholder,
+// help, min, max, step, pattern, autocomplete, rows and options
+// ("value:Label" pairs separated by |), and the flags required,
+// disabled and readonly. Values containing commas are wrapped in single
+// quotes, e.g. placeholder='Smith, John', doubling quotes inside them.
+// Without a type, booleans render as checkboxes,
+// numbers as number inputs, time.Time as date inputs, strings with
+// options as selects and slices with options as checkbox groups.
+// Besides the input types, type accepts textarea, select, radio,
+// checkbox and hidden. Embedded structs are flattened, promoting the
+// exported fields of unexported ones like encoding/json. Messages in the
+// Errors option are bound to the controls of their fields, and the
+// Values option re-renders submitted input after a failed Decode.
+//
+// Rendering fails when v is not a struct or a non-nil pointer to one.
+func FromStruct(v any, opts ...FromStructOptions) templ.Component {
+	var o FromStructOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		rv := reflect.ValueOf(v)
+		for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+			rv = rv.Elem()
+		}
+		if rv.Kind() != reflect.Struct {
+			return errors.New("form: FromStruct requires a struct or pointer to struct")
+		}
+		var fields []formField
+		for _, sf := range structFields(rv.Type()) {
+			fields = append(fields, resolveField(rv, sf, o))
+		}
+		if o.SubmitLabel == "" {
+			o.SubmitLabel = "Submit"
+		}
+		return structForm(fields, o).Render(ctx, w)
+	})
+}
+
+// Helper function to resolve a struct field's ID, choices and current value
+func resolveField(rv reflect.Value, sf structField, o FromStructOptions) formField {
+	f := formField{
+		fieldTag: sf.Tag,
+		ID:       o.IDPrefix + sf.Tag.Name,
+		Multiple: sf.Type.Kind() == reflect.Slice && sf.Type != timeType,
+	}
+	if choices, ok := o.Choices[f.Name]; ok {
+		f.Options = choices
+	}
+	if values, ok := o.Values[f.Name]; ok {
+		switch {
+		case f.Multiple:
+			f.Values = values
+		case sf.Type.Kind() == reflect.Bool:
+			f.Checked = len(values) > 0 && isTruthy(values[len(values)-1])
+		case len(values) > 0:
+			f.Value = values[0]
+		}
+		return f
+	}
+
+	fv, err := rv.FieldByIndexErr(sf.Index)
+	if err != nil {
+		return f
+	}
+	for fv.Kind() == reflect.Pointer {
+		if fv.IsNil() {
+			return f
+		}
+		fv = fv.Elem()
+	}
+	switch {
+	case f.Multiple:
+		for i := range fv.Len() {
+			if s, ok := formatValue(fv.Index(i), f.Type); ok {
+				f.Values = append(f.Values, s)
+			}
+		}
+	case fv.Kind() == reflect.Bool:
+		f.Checked = fv.Bool()
+	default:
+		f.Value, _ = formatValue(fv, f.Type)
+	}
+	return f
+}
+
+// Helper function to return the selected values of a select field, so a
+// multi-select with nothing selected doesn't select its empty option
+func selectedOptions(f formField) selectbox.OptionsProps {
+	if f.Multiple {
+		return selectbox.OptionsProps{Values: append([]string{}, f.Values...)}
+	}
+	return selectbox.OptionsProps{Value: f.Value}
+}
+
+// Helper function to format a scalar value for an input of the given type
+func formatValue(v reflect.Value, typ string) (string, bool) {
+	if t, ok := v.Interface().(time.Time); ok {
+		if t.IsZero() {
+			return "", true
+		}
+		return t.Format(timeLayout(typ)), true
+	}
+	if m, ok := v.Interface().(interface{ MarshalText() ([]byte, error) }); ok {
+		b, err := m.MarshalText()
+		return string(b), err == nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), true
+	}
+	return "", false
+}
+
+// Helper function to return the time layout used by a date or time input type
+func timeLayout(typ string) string {
+	switch typ {
+	case "datetime-local":
+		return "2006-01-02T15:04"
+	case "time":
+		return "15:04"
+	case "month":
+		return "2006-01"
+	default:
+		return time.DateOnly
+	}
+}
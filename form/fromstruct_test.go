@@ -0,0 +1,117 @@
+package form
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a-h/templ"
+)
+
+func TestFromStruct(t *testing.T) {
+	type Login struct {
+		Email    string `form:"label=Email address,type=email,required,placeholder=you@example.com"`
+		Password string `form:"type=password,autocomplete=current-password"`
+		Remember bool
+	}
+	type Profile struct {
+		Bio  string   `form:"type=textarea,rows=4,help=Markdown supported"`
+		Plan string   `form:"type=radio,options=free:Free|pro:Pro"`
+		Tags []string `form:"options=go|templ"`
+	}
+	type Event struct {
+		ID      int       `form:"type=hidden"`
+		Country string    `form:"options=ca:Canada|us:United States,placeholder=Choose"`
+		Langs   []string  `form:"type=select"`
+		Starts  time.Time `form:"type=datetime-local"`
+		Seats   *int      `form:"min=1,disabled"`
+	}
+
+	seats := 25
+	tests := []struct {
+		name   string
+		value  any
+		opts   FromStructOptions
+		expect string
+	}{
+		{
+			name:   "Inputs and checkbox",
+			value:  Login{Email: "jane@example.com", Remember: true},
+			expect: `<form class=""><div class="field"><label for="email" class="label">Email address</label> <div class="control"><input id="email" type="email" name="email" value="jane@example.com" placeholder="you@example.com" required class="input"></div> </div><div class="field"><label for="password" class="label">Password</label> <div class="control"><input id="password" type="password" name="password" autocomplete="current-password" class="input"></div> </div><div class="field"><div class="control"><label id="remember" class="checkbox"><input type="checkbox" name="remember" value="true" checked> Remember</label></div> </div> <div class="field"><div class="control"><button type="submit" class="button is-primary">Submit</button></div></div></form>`,
+		},
+		{
+			name:   "Textarea, radios and checkbox group",
+			value:  &Profile{Bio: "Hi", Plan: "pro", Tags: []string{"templ"}},
+			opts:   FromStructOptions{IDPrefix: "profile-", SubmitLabel: "Save"},
+			expect: `<form class=""><div class="field"><label for="profile-bio" class="label">Bio</label> <div class="control"><textarea id="profile-bio" name="bio" rows="4" class="textarea">Hi</textarea></div> <p class="help">Markdown supported</p></div><div class="field"><div class="control"><fieldset id="profile-plan" class=""><legend class="label">Plan</legend><div class="radios"><label class="radio"><input id="profile-plan-0" type="radio" name="plan" value="free"> Free</label><label class="radio"><input id="profile-plan-1" type="radio" name="plan" value="pro" checked> Pro</label></div></fieldset></div> </div><div class="field"><div class="control"><fieldset id="profile-tags" class=""><legend class="label">Tags</legend><div class="checkboxes"><label class="checkbox"><input id="profile-tags-0" type="checkbox" name="tags" value="go"> go</label><label class="checkbox"><input id="profile-tags-1" type="checkbox" name="tags" value="templ" checked> templ</label></div></fieldset></div> </div> <div class="field"><div class="control"><button type="submit" class="button is-primary">Save</button></div></div></form>`,
+		},
+		{
+			name: "Hidden, selects, dates and runtime choices",
+			value: Event{
+				ID:      7,
+				Country: "us",
+				Langs:   []string{"fr"},
+				Starts:  time.Date(2025, 3, 1, 18, 30, 0, 0, time.UTC),
+				Seats:   &seats,
+			},
+			opts: FromStructOptions{
+				Form:    FormProps{Method: "post"},
+				Choices: map[string][]Choice{"langs": {{Value: "en", Label: "English"}, {Value: "fr", Label: "French"}}},
+				Actions: templ.Raw(`<button class="button">Go</button>`),
+			},
+			expect: `<form method="post" class=""><input type="hidden" name="id" value="7"><div class="field"><label for="country" class="label">Country</label> <div class="control"><div class="select"><select id="country" name="country" class=""><option value="">Choose</option> <option value="ca">Canada</option><option value="us" selected>United States</option></select></div></div> </div><div class="field"><label for="langs" class="label">Langs</label> <div class="control"><div class="select is-multiple"><select id="langs" name="langs" multiple class=""> <option value="en">English</option><option value="fr" selected>French</option></select></div></div> </div><div class="field"><label for="starts" class="label">Starts</label> <div class="control"><input id="starts" type="datetime-local" name="starts" value="2025-03-01T18:30" class="input"></div> </div><div class="field"><label for="seats" class="label">Seats</label> <div class="control"><input id="seats" type="number" name="seats" value="25" min="1" disabled class="input"></div> </div> <button class="button">Go</button></form>`,
+		},
+		{
+			name:  "With errors",
+			value: Login{Email: "jane@"},
+			opts: FromStructOptions{
+				Errors:  Errors{"": {"Invalid credentials"}, "email": {"is invalid"}},
+				Actions: templ.Raw(`<button class="button">Log in</button>`),
+			},
+			expect: `<form class=""><p class="help is-danger">Invalid credentials </p><div class="field"><label for="email" class="label">Email address</label> <div class="control"><input id="email" type="email" name="email" value="jane@" placeholder="you@example.com" required class="input is-danger" aria-invalid="true" aria-describedby="email-error"><p id="email-error" class="help is-danger">is invalid </p></div> </div><div class="field"><label for="password" class="label">Password</label> <div class="control"><input id="password" type="password" name="password" autocomplete="current-password" class="input"></div> </div><div class="field"><div class="control"><label id="remember" class="checkbox"><input type="checkbox" name="remember" value="true"> Remember</label></div> </div> <button class="button">Log in</button></form>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := FromStruct(tt.value, tt.opts).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+
+	t.Run("Error IDs follow the ID prefix", func(t *testing.T) {
+		type Signup struct {
+			Email   string
+			Country string `form:"options=ca|us"`
+			Accept  bool
+		}
+		var buf strings.Builder
+		err := FromStruct(Signup{}, FromStructOptions{
+			IDPrefix: "signup-",
+			Errors:   Errors{"email": {"is required"}, "country": {"is required"}, "accept": {"is required"}},
+		}).Render(context.Background(), &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		for _, id := range []string{"signup-email-error", "signup-country-error", "signup-accept-error"} {
+			if !strings.Contains(buf.String(), `aria-describedby="`+id+`"`) || !strings.Contains(buf.String(), `<p id="`+id+`"`) {
+				t.Errorf("expected help %q referenced by its control, got:\n%s", id, buf.String())
+			}
+		}
+	})
+
+	t.Run("Non-struct value", func(t *testing.T) {
+		var buf strings.Builder
+		if err := FromStruct("nope").Render(context.Background(), &buf); err == nil {
+			t.Error("expected an error for a non-struct value")
+		}
+	})
+}
@@ -121,6 +121,16 @@ func TestInput(t *testing.T) {
 			},
 			expect: `<input id="user-email" type="email" name="email" placeholder="Enter email" autocomplete="email" required class="input is-medium is-success is-rounded form-control validated">`,
 		},
+		{
+			name:   "With errors",
+			props:  InputProps{ID: "email", Name: "email", Errors: map[string][]string{"email": {"is required"}}},
+			expect: `<input id="email" type="text" name="email" class="input is-danger" aria-invalid="true" aria-describedby="email-error"><p id="email-error" class="help is-danger">is required </p>`,
+		},
+		{
+			name:   "With errors for another field",
+			props:  InputProps{Name: "name", Errors: map[string][]string{"email": {"is required"}}},
+			expect: `<input type="text" name="name" class="input">`,
+		},
 	}
 
 	for _, tt := range tests {
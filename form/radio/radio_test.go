@@ -88,12 +88,12 @@ func TestRadios(t *testing.T) {
 		{
 			name:   "Default",
 			props:  RadiosProps{},
-			expect: `<div class="radios"></div>`,
+			expect: `<div class="radios" role="radiogroup"></div>`,
 		},
 		{
 			name:   "With ID and classes",
 			props:  RadiosProps{ID: "test-radios", Class: []string{"custom", "form-group"}},
-			expect: `<div id="test-radios" class="radios custom form-group"></div>`,
+			expect: `<div id="test-radios" class="radios custom form-group" role="radiogroup"></div>`,
 		},
 		{
 			name: "Complete configuration",
@@ -101,7 +101,12 @@ func TestRadios(t *testing.T) {
 				ID:    "size-radios",
 				Class: []string{"form-group", "size-selection"},
 			},
-			expect: `<div id="size-radios" class="radios form-group size-selection"></div>`,
+			expect: `<div id="size-radios" class="radios form-group size-selection" role="radiogroup"></div>`,
+		},
+		{
+			name:   "With errors",
+			props:  RadiosProps{Name: "email", Errors: map[string][]string{"email": {"is required"}}},
+			expect: `<div class="radios" role="radiogroup" aria-invalid="true" aria-describedby="email-error"></div><p id="email-error" class="help is-danger">is required </p>`,
 		},
 	}
 
@@ -340,3 +345,62 @@ func TestRadioAttributes(t *testing.T) {
 		}
 	}
 }
+
+func TestGroup(t *testing.T) {
+	options := []Option{{Value: "free", Label: "Free"}, {Value: "pro", Label: "Pro"}}
+	tests := []struct {
+		name   string
+		props  GroupProps
+		expect string
+	}{
+		{
+			name:   "Empty",
+			props:  GroupProps{},
+			expect: `<fieldset class=""><div class="radios"></div></fieldset>`,
+		},
+		{
+			name:   "Options with checked value",
+			props:  GroupProps{Name: "plan", Legend: "Plan", Options: options, Value: "pro"},
+			expect: `<fieldset class=""><legend class="label">Plan</legend><div class="radios"><label class="radio"><input id="plan-0" type="radio" name="plan" value="free"> Free</label><label class="radio"><input id="plan-1" type="radio" name="plan" value="pro" checked> Pro</label></div></fieldset>`,
+		},
+		{
+			name:   "IDs prefixed with group ID",
+			props:  GroupProps{ID: "billing-plan", Name: "plan", Options: options[:1]},
+			expect: `<fieldset id="billing-plan" class=""><div class="radios"><label class="radio"><input id="billing-plan-0" type="radio" name="plan" value="free"> Free</label></div></fieldset>`,
+		},
+		{
+			name:   "Disabled option and label defaulting to value",
+			props:  GroupProps{Name: "plan", Options: []Option{{Value: "team", Disabled: true}}},
+			expect: `<fieldset class=""><div class="radios"><label class="radio"><input id="plan-0" type="radio" name="plan" value="team" disabled> team</label></div></fieldset>`,
+		},
+		{
+			name:   "Stacked, disabled and required",
+			props:  GroupProps{Name: "plan", Options: options[:1], IsStacked: true, Disabled: true, Required: true},
+			expect: `<fieldset disabled class=""><div class="radios is-flex-direction-column"><label class="radio"><input id="plan-0" type="radio" name="plan" value="free" required> Free</label></div></fieldset>`,
+		},
+		{
+			name:   "With errors",
+			props:  GroupProps{Name: "plan", Options: options[:1], Errors: map[string][]string{"plan": {"is required"}}},
+			expect: `<fieldset class="" aria-describedby="plan-error"><div class="radios"><label class="radio"><input id="plan-0" type="radio" name="plan" value="free" aria-invalid="true"> Free</label></div></fieldset><p id="plan-error" class="help is-danger">is required </p>`,
+		},
+		{
+			name:   "Custom class and attributes",
+			props:  GroupProps{Class: []string{"custom"}, Attributes: templ.Attributes{"data-test": "value"}},
+			expect: `<fieldset class="custom" data-test="value"><div class="radios"></div></fieldset>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := Group(tt.props).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
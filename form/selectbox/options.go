@@ -0,0 +1,85 @@
+package selectbox
+
+import "slices"
+
+// OptionItem is a single choice rendered by Options.
+type OptionItem struct {
+	// Value sent when the option is selected
+	Value string
+
+	// Display text of the option (defaults to Value)
+	Label string
+
+	// Disable option selection
+	Disabled bool
+
+	// Label of the optgroup the option belongs to; options sharing a
+	// Group are rendered together in one optgroup
+	Group string
+}
+
+// OptionsFrom builds option items from any slice, using key for each
+// option's value and label for its display text.
+//
+//	selectbox.OptionsFrom(countries,
+//		func(c Country) string { return c.Code },
+//		func(c Country) string { return c.Name },
+//	)
+func OptionsFrom[T any](items []T, key func(T) string, label func(T) string) []OptionItem {
+	options := make([]OptionItem, 0, len(items))
+	for _, item := range items {
+		o := OptionItem{Value: key(item)}
+		if label != nil {
+			o.Label = label(item)
+		}
+		options = append(options, o)
+	}
+	return options
+}
+
+// optionGroup is a run of options rendered together, inside an
+// optgroup when Label is set.
+type optionGroup struct {
+	Label string
+	Items []OptionItem
+}
+
+// Helper function to collect options into optgroups in order of first appearance
+func groupOptions(items []OptionItem) []optionGroup {
+	var groups []optionGroup
+	index := map[string]int{}
+	for _, item := range items {
+		if item.Group == "" {
+			if n := len(groups); n > 0 && groups[n-1].Label == "" {
+				groups[n-1].Items = append(groups[n-1].Items, item)
+			} else {
+				groups = append(groups, optionGroup{Items: []OptionItem{item}})
+			}
+			continue
+		}
+		if i, ok := index[item.Group]; ok {
+			groups[i].Items = append(groups[i].Items, item)
+			continue
+		}
+		index[item.Group] = len(groups)
+		groups = append(groups, optionGroup{Label: item.Group, Items: []OptionItem{item}})
+	}
+	return groups
+}
+
+// Helper function to return the display text of an option
+func optionLabel(o OptionItem) string {
+	if o.Label != "" {
+		return o.Label
+	}
+	return o.Value
+}
+
+// Helper function to report whether an option is selected, matching Values
+// when set and Value otherwise
+func optionSelected(o OptionItem, p OptionsProps) bool {
+	if p.Values != nil {
+		return slices.Contains(p.Values, o.Value)
+	}
+	return o.Value == p.Value
+}
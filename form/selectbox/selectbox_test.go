@@ -3,6 +3,8 @@ package selectbox
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -73,6 +75,16 @@ func TestSelect(t *testing.T) {
 			},
 			expect: `<div id="country-select" class="select is-multiple is-rounded is-fullwidth is-medium is-success form-control validated"></div>`,
 		},
+		{
+			name:   "With errors",
+			props:  SelectProps{Name: "email", Errors: map[string][]string{"email": {"is required"}}},
+			expect: `<div class="select is-danger"></div><p id="email-error" class="help is-danger">is required </p>`,
+		},
+		{
+			name:   "With errors for select ID",
+			props:  SelectProps{Name: "email", For: "signup-email", Errors: map[string][]string{"email": {"is required"}}},
+			expect: `<div class="select is-danger"></div><p id="signup-email-error" class="help is-danger">is required </p>`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -126,6 +138,16 @@ func TestSelectElement(t *testing.T) {
 			props:  SelectElementProps{Required: true},
 			expect: `<select required class=""></select>`,
 		},
+		{
+			name:   "With errors",
+			props:  SelectElementProps{Name: "email", Errors: map[string][]string{"email": {"is required"}}},
+			expect: `<select name="email" class="" aria-invalid="true" aria-describedby="email-error"></select>`,
+		},
+		{
+			name:   "With errors and ID",
+			props:  SelectElementProps{ID: "signup-email", Name: "email", Errors: map[string][]string{"email": {"is required"}}},
+			expect: `<select id="signup-email" name="email" class="" aria-invalid="true" aria-describedby="signup-email-error"></select>`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -302,3 +324,93 @@ func TestSelectAttributes(t *testing.T) {
 		}
 	}
 }
+
+func TestOptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		items  []OptionItem
+		props  OptionsProps
+		expect string
+	}{
+		{
+			name:   "Empty",
+			expect: ``,
+		},
+		{
+			name:   "Label defaults to value",
+			items:  []OptionItem{{Value: "a"}, {Value: "b", Label: "Bee"}},
+			expect: `<option value="a">a</option><option value="b">Bee</option>`,
+		},
+		{
+			name:   "Single selected value",
+			items:  []OptionItem{{Value: "a"}, {Value: "b"}},
+			props:  OptionsProps{Value: "b"},
+			expect: `<option value="a">a</option><option value="b" selected>b</option>`,
+		},
+		{
+			name:   "Multiple selected values",
+			items:  []OptionItem{{Value: "a"}, {Value: "b"}, {Value: "c"}},
+			props:  OptionsProps{Values: []string{"a", "c"}},
+			expect: `<option value="a" selected>a</option><option value="b">b</option><option value="c" selected>c</option>`,
+		},
+		{
+			name:   "Multiple selected values ignore empty value",
+			items:  []OptionItem{{Value: "", Label: "Any"}, {Value: "a"}, {Value: "b"}},
+			props:  OptionsProps{Values: []string{"a"}},
+			expect: `<option value="">Any</option><option value="a" selected>a</option><option value="b">b</option>`,
+		},
+		{
+			name:   "Disabled placeholder selected by empty value",
+			items:  []OptionItem{{Value: "", Label: "Choose…", Disabled: true}},
+			expect: `<option value="" selected disabled>Choose…</option>`,
+		},
+		{
+			name: "Grouped",
+			items: []OptionItem{
+				{Value: "", Label: "Any"},
+				{Value: "ca", Label: "Canada", Group: "America"},
+				{Value: "fr", Label: "France", Group: "Europe"},
+				{Value: "us", Label: "United States", Group: "America"},
+			},
+			props:  OptionsProps{Value: "fr"},
+			expect: `<option value="">Any</option><optgroup label="America"><option value="ca">Canada</option><option value="us">United States</option></optgroup><optgroup label="Europe"><option value="fr" selected>France</option></optgroup>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := Options(tt.items, tt.props).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestOptionsFrom(t *testing.T) {
+	type country struct {
+		Code string
+		Name string
+	}
+	countries := []country{{"ca", "Canada"}, {"fr", "France"}}
+
+	got := OptionsFrom(countries,
+		func(c country) string { return c.Code },
+		func(c country) string { return c.Name },
+	)
+	expect := []OptionItem{{Value: "ca", Label: "Canada"}, {Value: "fr", Label: "France"}}
+	if !slices.Equal(got, expect) {
+		t.Errorf("expected %v, got %v", expect, got)
+	}
+
+	got = OptionsFrom([]int{1, 2}, strconv.Itoa, nil)
+	expect = []OptionItem{{Value: "1"}, {Value: "2"}}
+	if !slices.Equal(got, expect) {
+		t.Errorf("expected %v, got %v", expect, got)
+	}
+}
@@ -0,0 +1,187 @@
+package slider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestSlider(t *testing.T) {
+	tests := []struct {
+		name   string
+		props  SliderProps
+		expect string
+	}{
+		{
+			name:   "Default",
+			props:  SliderProps{},
+			expect: `<input type="range" class="slider">`,
+		},
+		{
+			name:   "Form attributes",
+			props:  SliderProps{ID: "volume", Name: "volume", Value: "40", Min: "0", Max: "100", Step: "5", Required: true},
+			expect: `<input id="volume" type="range" name="volume" value="40" min="0" max="100" step="5" required class="slider">`,
+		},
+		{
+			name:   "Disabled",
+			props:  SliderProps{Disabled: true},
+			expect: `<input type="range" disabled class="slider">`,
+		},
+		{
+			name:   "Color, size and fullwidth",
+			props:  SliderProps{Color: IsPrimary, Size: IsLarge, IsFullwidth: true},
+			expect: `<input type="range" class="slider is-large is-primary is-fullwidth">`,
+		},
+		{
+			name:   "Ticks",
+			props:  SliderProps{ID: "volume", Ticks: []Tick{{Value: "0", Label: "Mute"}, {Value: "50"}, {Value: "100", Label: "Max"}}},
+			expect: `<input id="volume" type="range" list="volume-ticks" class="slider"> <datalist id="volume-ticks"><option value="0" label="Mute"></option><option value="50"></option><option value="100" label="Max"></option></datalist>`,
+		},
+		{
+			name:   "Ticks require an ID",
+			props:  SliderProps{Ticks: []Tick{{Value: "0"}}},
+			expect: `<input type="range" class="slider">`,
+		},
+		{
+			name:   "Output",
+			props:  SliderProps{ID: "volume", Value: "40", HasOutput: true},
+			expect: `<input id="volume" type="range" value="40" class="slider"> <output for="volume" class="slider-output">40</output>`,
+		},
+		{
+			name:   "With errors",
+			props:  SliderProps{Name: "volume", Color: IsSuccess, Errors: map[string][]string{"volume": {"is too loud"}}},
+			expect: `<input type="range" name="volume" class="slider is-danger is-success" aria-invalid="true" aria-describedby="volume-error"> <p id="volume-error" class="help is-danger">is too loud </p>`,
+		},
+		{
+			name:   "Custom class and attributes",
+			props:  SliderProps{Class: []string{"custom"}, Attributes: templ.Attributes{"aria-label": "Volume"}},
+			expect: `<input type="range" class="slider custom" aria-label="Volume">`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			ctx := templ.InitializeContext(context.Background())
+			// Render the shared stylesheet and script first so only the slider is compared
+			if err := SliderStyle().Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			if err := SliderScript().Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			buf.Reset()
+			err := Slider(tt.props).Render(ctx, &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+
+	t.Run("Output includes script once", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.InitializeContext(context.Background())
+		for _, id := range []string{"volume", "balance"} {
+			if err := Slider(SliderProps{ID: id, HasOutput: true}).Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+		}
+		got := buf.String()
+		if n := strings.Count(got, "<style"); n != 1 {
+			t.Errorf("expected stylesheet to be rendered once, got %d", n)
+		}
+		if n := strings.Count(got, "<script"); n != 1 {
+			t.Errorf("expected script to be rendered once, got %d", n)
+		}
+	})
+
+	t.Run("No script without output", func(t *testing.T) {
+		var buf strings.Builder
+		err := Slider(SliderProps{ID: "volume"}).Render(context.Background(), &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		if strings.Contains(buf.String(), "<script") {
+			t.Errorf("expected no script, got: %s", buf.String())
+		}
+	})
+}
+
+func TestSliderStyle(t *testing.T) {
+	t.Run("Without nonce", func(t *testing.T) {
+		var buf strings.Builder
+		err := SliderStyle().Render(context.Background(), &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		got := buf.String()
+		if !strings.HasPrefix(got, "<style>") {
+			t.Errorf("expected stylesheet without nonce, got: %s", got)
+		}
+		for _, rule := range []string{".slider {", "::-webkit-slider-thumb", "::-moz-range-thumb", ".slider.is-primary", ".slider-output"} {
+			if !strings.Contains(got, rule) {
+				t.Errorf("expected stylesheet to contain %q", rule)
+			}
+		}
+	})
+
+	t.Run("With nonce", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.WithNonce(context.Background(), "r4nd0m")
+		err := SliderStyle().Render(ctx, &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		if !strings.HasPrefix(buf.String(), `<style nonce="r4nd0m">`) {
+			t.Errorf("expected stylesheet with nonce, got: %s", buf.String())
+		}
+	})
+}
+
+func TestSliderScript(t *testing.T) {
+	t.Run("Without nonce", func(t *testing.T) {
+		var buf strings.Builder
+		err := SliderScript().Render(context.Background(), &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		got := buf.String()
+		if !strings.HasPrefix(got, "<script>") {
+			t.Errorf("expected script without nonce, got: %s", got)
+		}
+		if !strings.Contains(got, "output.slider-output") {
+			t.Errorf("expected script to target slider outputs, got: %s", got)
+		}
+	})
+
+	t.Run("With nonce", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.WithNonce(context.Background(), "r4nd0m")
+		err := SliderScript().Render(ctx, &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		if !strings.HasPrefix(buf.String(), `<script nonce="r4nd0m">`) {
+			t.Errorf("expected script with nonce, got: %s", buf.String())
+		}
+	})
+
+	t.Run("Rendered once per context", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.InitializeContext(context.Background())
+		for range 2 {
+			if err := SliderScript().Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+		}
+		if n := strings.Count(buf.String(), "<script"); n != 1 {
+			t.Errorf("expected script to be rendered once, got %d", n)
+		}
+	})
+}
@@ -0,0 +1,316 @@
+// Code generated by templ - DO NOT EDIT.
+
+// templ: version: v0.3.943
+package switchbox
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import templruntime "github.com/a-h/templ/runtime"
+
+import (
+	"strings"
+
+	"github.com/alexferl/templaui/internal/csp"
+)
+
+// Size represents switch size modifiers
+type Size string
+
+const (
+	IsSmall  Size = "is-small"  // Small switch size
+	IsNormal Size = "is-normal" // Normal switch size (default)
+	IsMedium Size = "is-medium" // Medium switch size
+	IsLarge  Size = "is-large"  // Large switch size
+)
+
+// Color represents switch color modifiers applied to the on state
+type Color string
+
+const (
+	IsPrimary Color = "is-primary" // Primary switch color (brand)
+	IsLink    Color = "is-link"    // Link switch color (default)
+	IsInfo    Color = "is-info"    // Info switch color (blue)
+	IsSuccess Color = "is-success" // Success switch color (green)
+	IsWarning Color = "is-warning" // Warning switch color (yellow)
+	IsDanger  Color = "is-danger"  // Danger switch color (red)
+)
+
+// SwitchProps defines configuration for toggle switch inputs.
+//
+// Use this type to configure on/off toggles built on native checkbox
+// inputs with role="switch", so they submit like checkboxes and are
+// announced as switches by assistive technologies. Bulma core has no
+// switch, so the styles are provided by SwitchStyle.
+type SwitchProps struct {
+	// Optional HTML id attribute for the switch input
+	ID string
+
+	// List of additional CSS classes to apply to the switch label
+	Class []string
+
+	// Additional arbitrary HTML attributes for the switch input
+	Attributes templ.Attributes
+
+	// Name attribute for form submission
+	Name string
+
+	// Value attribute sent when the switch is on
+	Value string
+
+	// Initial on state of the switch
+	Checked bool
+
+	// Disable switch interaction
+	Disabled bool
+
+	// Mark switch as required for form validation
+	Required bool
+
+	// Switch color when on (primary, link, info, success, warning, danger)
+	Color Color
+
+	// Switch size (small, normal, medium, large)
+	Size Size
+
+	// Apply fully rounded track and thumb
+	IsRounded bool
+
+	// Render a thin track with the thumb overlapping it
+	IsThin bool
+
+	// Render an outlined track instead of a filled one
+	IsOutlined bool
+}
+
+// Switch renders labeled toggle switch inputs.
+//
+// This component renders a .switch label containing an
+// input[type="checkbox"][role="switch"] followed by the visual track
+// and the label text provided as children content. The input stays
+// focusable and keyboard operable, the track being hidden from
+// assistive technologies. The stylesheet is included once per request
+// through SwitchStyle. Best used within field and control containers
+// for consistent form layout.
+func Switch(props ...SwitchProps) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var1 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var1 == nil {
+			templ_7745c5c3_Var1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		var p SwitchProps
+		if len(props) > 0 {
+			p = props[0]
+		}
+		templ_7745c5c3_Err = SwitchStyle().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var2 = []any{"switch",
+			templ.KV(string(p.Color), p.Color != ""),
+			templ.KV(string(p.Size), p.Size != ""),
+			templ.KV("is-rounded", p.IsRounded),
+			templ.KV("is-thin", p.IsThin),
+			templ.KV("is-outlined", p.IsOutlined),
+			templ.KV(strings.Join(p.Class, " "), len(p.Class) > 0),
+		}
+		templ_7745c5c3_Err = templ.RenderCSSItems(ctx, templ_7745c5c3_Buffer, templ_7745c5c3_Var2...)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<label class=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var3 string
+		templ_7745c5c3_Var3, templ_7745c5c3_Err = templ.JoinStringErrs(templ.CSSClasses(templ_7745c5c3_Var2).String())
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `form/switchbox/switchbox.templ`, Line: 1, Col: 0}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var3))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 2, "\"><input")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if p.ID != "" {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 3, " id=\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var4 string
+			templ_7745c5c3_Var4, templ_7745c5c3_Err = templ.JoinStringErrs(p.ID)
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `form/switchbox/switchbox.templ`, Line: 106, Col: 13}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var4))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 4, "\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 5, " type=\"checkbox\" role=\"switch\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if p.Name != "" {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 6, " name=\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var5 string
+			templ_7745c5c3_Var5, templ_7745c5c3_Err = templ.JoinStringErrs(p.Name)
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `form/switchbox/switchbox.templ`, Line: 111, Col: 17}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var5))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 7, "\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		if p.Value != "" {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 8, " value=\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var6 string
+			templ_7745c5c3_Var6, templ_7745c5c3_Err = templ.JoinStringErrs(p.Value)
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `form/switchbox/switchbox.templ`, Line: 114, Col: 19}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var6))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 9, "\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		if p.Checked {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 10, " checked")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		if p.Disabled {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 11, " disabled")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		if p.Required {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 12, " required")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = templ.RenderAttributes(ctx, templ_7745c5c3_Buffer, p.Attributes)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 13, "> <span class=\"switch-track\" aria-hidden=\"true\"></span>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templ_7745c5c3_Var1.Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 14, "</label>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+var switchStyleHandle = templ.NewOnceHandle()
+
+// SwitchStyle renders the stylesheet of Switch components.
+//
+// The styles are rendered once per request, so Switch includes them
+// automatically; render SwitchStyle in the document head to load them
+// before the first switch. The style element carries the request's CSP
+// nonce when one is set with templ.WithNonce. Colors and sizes follow
+// Bulma's CSS variables, so switches match the active theme.
+func SwitchStyle() templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var7 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var7 == nil {
+			templ_7745c5c3_Var7 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Var8 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+			templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+			if !templ_7745c5c3_IsBuffer {
+				defer func() {
+					templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+					if templ_7745c5c3_Err == nil {
+						templ_7745c5c3_Err = templ_7745c5c3_BufErr
+					}
+				}()
+			}
+			ctx = templ.InitializeContext(ctx)
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 15, "<style")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templ.RenderAttributes(ctx, templ_7745c5c3_Buffer, csp.NonceAttrs(ctx))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 16, ">\n\t\t\t.switch {\n\t\t\t\t--switch-width: 2.75em;\n\t\t\t\t--switch-height: 1.5em;\n\t\t\t\t--switch-thumb: 1.1em;\n\t\t\t\t--switch-color: var(--bulma-link, hsl(233, 100%, 63%));\n\t\t\t\t--switch-off: var(--bulma-border, hsl(221, 14%, 86%));\n\t\t\t\tposition: relative;\n\t\t\t\tdisplay: inline-flex;\n\t\t\t\talign-items: center;\n\t\t\t\tgap: 0.5em;\n\t\t\t\tcursor: pointer;\n\t\t\t\tuser-select: none;\n\t\t\t}\n\t\t\t.switch.is-primary { --switch-color: var(--bulma-primary); }\n\t\t\t.switch.is-link { --switch-color: var(--bulma-link); }\n\t\t\t.switch.is-info { --switch-color: var(--bulma-info); }\n\t\t\t.switch.is-success { --switch-color: var(--bulma-success); }\n\t\t\t.switch.is-warning { --switch-color: var(--bulma-warning); }\n\t\t\t.switch.is-danger { --switch-color: var(--bulma-danger); }\n\t\t\t.switch.is-small { font-size: var(--bulma-size-small, 0.75rem); }\n\t\t\t.switch.is-medium { font-size: var(--bulma-size-medium, 1.25rem); }\n\t\t\t.switch.is-large { font-size: var(--bulma-size-large, 1.5rem); }\n\t\t\t.switch > input[role=\"switch\"] {\n\t\t\t\tposition: absolute;\n\t\t\t\twidth: 1px;\n\t\t\t\theight: 1px;\n\t\t\t\tmargin: 0;\n\t\t\t\topacity: 0;\n\t\t\t}\n\t\t\t.switch-track {\n\t\t\t\tposition: relative;\n\t\t\t\tflex-shrink: 0;\n\t\t\t\twidth: var(--switch-width);\n\t\t\t\theight: var(--switch-height);\n\t\t\t\tborder-radius: var(--bulma-radius, 0.375em);\n\t\t\t\tbackground-color: var(--switch-off);\n\t\t\t\ttransition: background-color 86ms ease-out, box-shadow 86ms ease-out;\n\t\t\t}\n\t\t\t.switch-track::before {\n\t\t\t\tcontent: \"\";\n\t\t\t\tposition: absolute;\n\t\t\t\ttop: 50%;\n\t\t\t\tleft: 0.2em;\n\t\t\t\twidth: var(--switch-thumb);\n\t\t\t\theight: var(--switch-thumb);\n\t\t\t\tmargin-top: calc(var(--switch-thumb) / -2);\n\t\t\t\tborder-radius: var(--bulma-radius-small, 0.25em);\n\t\t\t\tbackground-color: var(--bulma-scheme-main, hsl(0, 0%, 100%));\n\t\t\t\ttransition: transform 86ms ease-out, background-color 86ms ease-out;\n\t\t\t}\n\t\t\t.switch.is-rounded .switch-track,\n\t\t\t.switch.is-rounded .switch-track::before {\n\t\t\t\tborder-radius: 9999px;\n\t\t\t}\n\t\t\t.switch.is-thin .switch-track {\n\t\t\t\theight: calc(var(--switch-height) / 2.5);\n\t\t\t}\n\t\t\t.switch.is-thin .switch-track::before {\n\t\t\t\t--switch-thumb: var(--switch-height);\n\t\t\t\tleft: 0;\n\t\t\t\tbox-shadow: 0 0.1em 0.25em hsla(0, 0%, 4%, 0.3);\n\t\t\t}\n\t\t\t.switch.is-outlined .switch-track {\n\t\t\t\tbackground-color: transparent;\n\t\t\t\tbox-shadow: inset 0 0 0 0.1em var(--switch-off);\n\t\t\t}\n\t\t\t.switch.is-outlined .switch-track::before {\n\t\t\t\tbackground-color: var(--switch-off);\n\t\t\t}\n\t\t\t.switch > input:checked + .switch-track {\n\t\t\t\tbackground-color: var(--switch-color);\n\t\t\t}\n\t\t\t.switch > input:checked + .switch-track::before {\n\t\t\t\ttransform: translateX(calc(var(--switch-width) - var(--switch-thumb) - 0.4em));\n\t\t\t}\n\t\t\t.switch.is-thin > input:checked + .switch-track::before {\n\t\t\t\ttransform: translateX(calc(var(--switch-width) - var(--switch-thumb)));\n\t\t\t}\n\t\t\t.switch.is-outlined > input:checked + .switch-track {\n\t\t\t\tbackground-color: transparent;\n\t\t\t\tbox-shadow: inset 0 0 0 0.1em var(--switch-color);\n\t\t\t}\n\t\t\t.switch.is-outlined > input:checked + .switch-track::before {\n\t\t\t\tbackground-color: var(--switch-color);\n\t\t\t}\n\t\t\t.switch > input:focus-visible + .switch-track {\n\t\t\t\toutline: 2px solid var(--switch-color);\n\t\t\t\toutline-offset: 2px;\n\t\t\t}\n\t\t\t.switch:has(> input:disabled) {\n\t\t\t\tcursor: not-allowed;\n\t\t\t\topacity: 0.5;\n\t\t\t}\n\t\t</style>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			return nil
+		})
+		templ_7745c5c3_Err = switchStyleHandle.Once().Render(templ.WithChildren(ctx, templ_7745c5c3_Var8), templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+var _ = templruntime.GeneratedTemplate
@@ -0,0 +1,109 @@
+package switchbox
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestSwitch(t *testing.T) {
+	tests := []struct {
+		name   string
+		props  SwitchProps
+		expect string
+	}{
+		{
+			name:   "Default",
+			props:  SwitchProps{},
+			expect: `<label class="switch"><input type="checkbox" role="switch"> <span class="switch-track" aria-hidden="true"></span>Notifications</label>`,
+		},
+		{
+			name:   "Form attributes",
+			props:  SwitchProps{ID: "notify", Name: "notify", Value: "on", Checked: true, Required: true},
+			expect: `<label class="switch"><input id="notify" type="checkbox" role="switch" name="notify" value="on" checked required> <span class="switch-track" aria-hidden="true"></span>Notifications</label>`,
+		},
+		{
+			name:   "Disabled",
+			props:  SwitchProps{Disabled: true},
+			expect: `<label class="switch"><input type="checkbox" role="switch" disabled> <span class="switch-track" aria-hidden="true"></span>Notifications</label>`,
+		},
+		{
+			name:   "Color and size",
+			props:  SwitchProps{Color: IsSuccess, Size: IsLarge},
+			expect: `<label class="switch is-success is-large"><input type="checkbox" role="switch"> <span class="switch-track" aria-hidden="true"></span>Notifications</label>`,
+		},
+		{
+			name:   "Style modifiers",
+			props:  SwitchProps{IsRounded: true, IsThin: true, IsOutlined: true},
+			expect: `<label class="switch is-rounded is-thin is-outlined"><input type="checkbox" role="switch"> <span class="switch-track" aria-hidden="true"></span>Notifications</label>`,
+		},
+		{
+			name:   "Custom class and attributes",
+			props:  SwitchProps{Class: []string{"custom"}, Attributes: templ.Attributes{"aria-describedby": "notify-help"}},
+			expect: `<label class="switch custom"><input type="checkbox" role="switch" aria-describedby="notify-help"> <span class="switch-track" aria-hidden="true"></span>Notifications</label>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			ctx := templ.InitializeContext(context.Background())
+			// Render the shared stylesheet first so only the switch is compared
+			if err := SwitchStyle().Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			buf.Reset()
+			ctx = templ.WithChildren(ctx, templ.Raw("Notifications"))
+			err := Switch(tt.props).Render(ctx, &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestSwitchStyle(t *testing.T) {
+	t.Run("Without nonce", func(t *testing.T) {
+		var buf strings.Builder
+		err := SwitchStyle().Render(context.Background(), &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		got := buf.String()
+		if !strings.HasPrefix(got, "<style>") {
+			t.Errorf("expected stylesheet without nonce, got: %s", got)
+		}
+		for _, rule := range []string{".switch {", ".switch-track::before", ".switch.is-thin", ".switch.is-outlined", "input:checked + .switch-track"} {
+			if !strings.Contains(got, rule) {
+				t.Errorf("expected stylesheet to contain %q", rule)
+			}
+		}
+	})
+
+	t.Run("Rendered once with nonce", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.WithNonce(templ.InitializeContext(context.Background()), "r4nd0m")
+		for range 2 {
+			ctx = templ.WithChildren(ctx, templ.Raw("Notifications"))
+			if err := Switch().Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+		}
+		got := buf.String()
+		if !strings.HasPrefix(got, `<style nonce="r4nd0m">`) {
+			t.Errorf("expected stylesheet with nonce, got: %s", got)
+		}
+		if n := strings.Count(got, "<style"); n != 1 {
+			t.Errorf("expected stylesheet to be rendered once, got %d", n)
+		}
+		if n := strings.Count(got, "Notifications"); n != 2 {
+			t.Errorf("expected two switches, got %d", n)
+		}
+	})
+}
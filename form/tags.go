@@ -0,0 +1,273 @@
+package form
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// TagName is the struct tag read by FromStruct and Decode.
+const TagName = "form"
+
+// Choice describes a selectable value of a select, radio or checkbox group field.
+type Choice struct {
+	// Submitted value of the choice
+	Value string
+
+	// Text displayed for the choice (defaults to Value)
+	Label string
+}
+
+// fieldTag holds the parsed options of a form struct tag, e.g.
+// `form:"label=Email,type=email,required,placeholder=you@example.com"`.
+type fieldTag struct {
+	Name         string
+	Label        string
+	Type         string
+	Placeholder  string
+	Help         string
+	Min          string
+	Max          string
+	Step         string
+	Pattern      string
+	Autocomplete string
+	Rows         int
+	Options      []Choice
+	Required     bool
+	Disabled     bool
+	Readonly     bool
+}
+
+// structField describes an exported struct field mapped to a form field.
+type structField struct {
+	// Index path of the field, for reflect.Value.FieldByIndex
+	Index []int
+
+	// Go type of the field, with pointers dereferenced
+	Type reflect.Type
+
+	// Parsed tag with defaults applied
+	Tag fieldTag
+}
+
+var (
+	timeType          = reflect.TypeFor[time.Time]()
+	textMarshalerType = reflect.TypeFor[interface{ MarshalText() ([]byte, error) }]()
+)
+
+// Helper function to parse a form struct tag
+func parseTag(tag string) fieldTag {
+	var t fieldTag
+	for _, part := range splitTag(tag) {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "name":
+			t.Name = value
+		case "label":
+			t.Label = value
+		case "type":
+			t.Type = value
+		case "placeholder":
+			t.Placeholder = value
+		case "help":
+			t.Help = value
+		case "min":
+			t.Min = value
+		case "max":
+			t.Max = value
+		case "step":
+			t.Step = value
+		case "pattern":
+			t.Pattern = value
+		case "autocomplete":
+			t.Autocomplete = value
+		case "rows":
+			t.Rows, _ = strconv.Atoi(value)
+		case "options":
+			t.Options = parseChoices(value)
+		case "required":
+			t.Required = true
+		case "disabled":
+			t.Disabled = true
+		case "readonly":
+			t.Readonly = true
+		}
+	}
+	return t
+}
+
+// Helper function to split a form struct tag into its comma-separated
+// options, trimming surrounding spaces. Values wrapped in single quotes,
+// e.g. placeholder='Smith, John', keep their commas and spaces, and a
+// doubled quote inside them stands for a literal one.
+func splitTag(tag string) []string {
+	var parts []string
+	var b strings.Builder
+	quoted := false
+	keep := 0 // length of b that trailing-space trimming must not cut into
+	for i := 0; i < len(tag); i++ {
+		c := tag[i]
+		switch {
+		case quoted && c == '\'':
+			if i+1 < len(tag) && tag[i+1] == '\'' {
+				b.WriteByte(c)
+				i++
+				continue
+			}
+			quoted = false
+			keep = b.Len()
+		case quoted:
+			b.WriteByte(c)
+		case c == ',':
+			parts = append(parts, trimPart(b.String(), keep))
+			b.Reset()
+			keep = 0
+		case c == '\'' && strings.HasSuffix(b.String(), "="):
+			quoted = true
+		case c == ' ' && b.Len() == 0:
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return append(parts, trimPart(b.String(), keep))
+}
+
+// Helper function to trim trailing spaces of a tag option, keeping the
+// first keep bytes (the contents of a quoted value) intact
+func trimPart(s string, keep int) string {
+	return s[:keep] + strings.TrimRight(s[keep:], " ")
+}
+
+// Helper function to parse "value:Label|value:Label" choices from a tag
+func parseChoices(s string) []Choice {
+	var choices []Choice
+	for option := range strings.SplitSeq(s, "|") {
+		if option == "" {
+			continue
+		}
+		value, label, ok := strings.Cut(option, ":")
+		if !ok {
+			label = value
+		}
+		choices = append(choices, Choice{Value: value, Label: label})
+	}
+	return choices
+}
+
+// structFields returns the form fields of a struct type in declaration
+// order. Unexported fields and fields tagged `form:"-"` are skipped and
+// embedded structs are flattened, including unexported ones.
+func structFields(t reflect.Type) []structField {
+	var fields []structField
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag, hasTag := f.Tag.Lookup(TagName)
+		if tag == "-" {
+			continue
+		}
+		ft := f.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		// Like encoding/json, the exported fields of embedded structs of
+		// unexported type are promoted, except behind pointers, which
+		// cannot be allocated when decoding.
+		if !f.IsExported() && (!f.Anonymous || hasTag || f.Type.Kind() != reflect.Struct) {
+			continue
+		}
+		if f.Anonymous && !hasTag && ft.Kind() == reflect.Struct && ft != timeType {
+			for _, nested := range structFields(ft) {
+				nested.Index = append([]int{i}, nested.Index...)
+				fields = append(fields, nested)
+			}
+			continue
+		}
+		parsed := parseTag(tag)
+		if parsed.Type == "" {
+			parsed.Type = inferType(ft, parsed)
+		}
+		if parsed.Type == "" {
+			continue
+		}
+		if parsed.Name == "" {
+			parsed.Name = snakeCase(f.Name)
+		}
+		if parsed.Label == "" {
+			parsed.Label = humanize(f.Name)
+		}
+		fields = append(fields, structField{Index: []int{i}, Type: ft, Tag: parsed})
+	}
+	return fields
+}
+
+// Helper function to pick the input type of a field without a type tag,
+// returning an empty type for unsupported fields
+func inferType(t reflect.Type, tag fieldTag) string {
+	switch {
+	case t == timeType:
+		return "date"
+	case t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		return "text"
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return "checkbox"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.String:
+		if len(tag.Options) > 0 {
+			return "select"
+		}
+		return "text"
+	case reflect.Slice:
+		if len(tag.Options) > 0 {
+			return "checkbox"
+		}
+	}
+	return ""
+}
+
+// Helper function to convert a Go field name to snake_case
+func snakeCase(name string) string {
+	words := splitWords(name)
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return strings.Join(words, "_")
+}
+
+// Helper function to convert a Go field name to a readable label,
+// keeping acronyms such as ID or URL intact
+func humanize(name string) string {
+	words := splitWords(name)
+	for i, w := range words {
+		if len(w) > 1 && strings.ToUpper(w) == w {
+			continue
+		}
+		if i > 0 {
+			words[i] = strings.ToLower(w)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// Helper function to split a CamelCase name into words, e.g.
+// "UserID" into ["User", "ID"] and "HTMLBody" into ["HTML", "Body"]
+func splitWords(name string) []string {
+	runes := []rune(name)
+	var words []string
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		prev, cur := runes[i-1], runes[i]
+		nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		if unicode.IsUpper(cur) && (unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower)) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	return append(words, string(runes[start:]))
+}
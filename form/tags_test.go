@@ -0,0 +1,105 @@
+package form
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseTag(t *testing.T) {
+	got := parseTag("name=mail,label=Email,type=email,required,placeholder=you@example.com,rows=3,options=a:A|b")
+	expect := fieldTag{
+		Name:        "mail",
+		Label:       "Email",
+		Type:        "email",
+		Placeholder: "you@example.com",
+		Rows:        3,
+		Options:     []Choice{{Value: "a", Label: "A"}, {Value: "b", Label: "b"}},
+		Required:    true,
+	}
+	if !reflect.DeepEqual(got, expect) {
+		t.Errorf("expected %+v, got %+v", expect, got)
+	}
+}
+
+func TestParseTagQuoted(t *testing.T) {
+	got := parseTag(" label='Name, full' , placeholder='Smith, John',help='It''s shown, as typed ',required")
+	expect := fieldTag{
+		Label:       "Name, full",
+		Placeholder: "Smith, John",
+		Help:        "It's shown, as typed ",
+		Required:    true,
+	}
+	if !reflect.DeepEqual(got, expect) {
+		t.Errorf("expected %+v, got %+v", expect, got)
+	}
+}
+
+func TestStructFields(t *testing.T) {
+	type Base struct {
+		ID int `form:"type=hidden"`
+	}
+	type audit struct {
+		CreatedBy string
+	}
+	type meta struct {
+		Note string
+	}
+	type Example struct {
+		Base
+		audit
+		*meta
+		FirstName string
+		Admin     bool
+		Score     *float64
+		Born      time.Time
+		Role      string   `form:"options=admin|user"`
+		Tags      []string `form:"options=a|b"`
+		Skipped   string   `form:"-"`
+		Unknown   map[string]string
+		hidden    string
+	}
+
+	var got []string
+	for _, f := range structFields(reflect.TypeFor[Example]()) {
+		got = append(got, f.Tag.Name+":"+f.Tag.Type+":"+f.Tag.Label)
+	}
+	expect := []string{
+		"id:hidden:ID",
+		"created_by:text:Created by",
+		"first_name:text:First name",
+		"admin:checkbox:Admin",
+		"score:number:Score",
+		"born:date:Born",
+		"role:select:Role",
+		"tags:checkbox:Tags",
+	}
+	if !reflect.DeepEqual(got, expect) {
+		t.Errorf("expected %v, got %v", expect, got)
+	}
+}
+
+func TestSplitWords(t *testing.T) {
+	tests := []struct {
+		name  string
+		snake string
+		label string
+	}{
+		{name: "Email", snake: "email", label: "Email"},
+		{name: "FirstName", snake: "first_name", label: "First name"},
+		{name: "UserID", snake: "user_id", label: "User ID"},
+		{name: "HTMLBody", snake: "html_body", label: "HTML body"},
+		{name: "Address2", snake: "address2", label: "Address2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := snakeCase(tt.name); got != tt.snake {
+				t.Errorf("snakeCase: expected %q, got %q", tt.snake, got)
+			}
+			if got := humanize(tt.name); got != tt.label {
+				t.Errorf("humanize: expected %q, got %q", tt.label, got)
+			}
+		})
+	}
+}
@@ -116,6 +116,11 @@ func TestTextarea(t *testing.T) {
 			},
 			expect: `<textarea id="user-message" name="message" placeholder="Enter message" rows="6" required class="textarea is-medium is-success has-fixed-size form-control validated">Sample text</textarea>`,
 		},
+		{
+			name:   "With errors",
+			props:  TextareaProps{Name: "email", Errors: map[string][]string{"email": {"is required"}}},
+			expect: `<textarea name="email" class="textarea is-danger" aria-invalid="true" aria-describedby="email-error"></textarea><p id="email-error" class="help is-danger">is required </p>`,
+		},
 	}
 
 	for _, tt := range tests {
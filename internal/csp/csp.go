@@ -0,0 +1,20 @@
+// Package csp provides Content-Security-Policy helpers shared by components
+// that render inline scripts and styles.
+package csp
+
+import (
+	"context"
+
+	"github.com/a-h/templ"
+)
+
+// NonceAttrs returns the nonce attribute for inline script and style
+// elements, using the nonce stored in ctx with templ.WithNonce. It
+// returns nil when no nonce is set so no empty attribute is rendered.
+func NonceAttrs(ctx context.Context) templ.Attributes {
+	nonce := templ.GetNonce(ctx)
+	if nonce == "" {
+		return nil
+	}
+	return templ.Attributes{"nonce": nonce}
+}
@@ -0,0 +1,20 @@
+package csp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestNonceAttrs(t *testing.T) {
+	if got := NonceAttrs(context.Background()); got != nil {
+		t.Errorf("expected nil attributes without nonce, got %v", got)
+	}
+
+	ctx := templ.WithNonce(context.Background(), "r4nd0m")
+	got := NonceAttrs(ctx)
+	if got["nonce"] != "r4nd0m" {
+		t.Errorf("expected nonce attribute %q, got %v", "r4nd0m", got)
+	}
+}
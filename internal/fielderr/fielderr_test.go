@@ -0,0 +1,69 @@
+package fielderr
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestHelp(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		field    string
+		messages []string
+		expect   string
+	}{
+		{
+			name:   "No messages",
+			field:  "email",
+			expect: ``,
+		},
+		{
+			name:     "Single message",
+			field:    "email",
+			messages: []string{"is required"},
+			expect:   `<p id="email-error" class="help is-danger">is required </p>`,
+		},
+		{
+			name:     "Multiple messages",
+			field:    "password",
+			messages: []string{"is too short", "must contain a digit"},
+			expect:   `<p id="password-error" class="help is-danger">is too short <br>must contain a digit </p>`,
+		},
+		{
+			name:     "Control ID",
+			id:       "signup-email",
+			field:    "email",
+			messages: []string{"is required"},
+			expect:   `<p id="signup-email-error" class="help is-danger">is required </p>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := Help(tt.id, tt.field, tt.messages).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestMessages(t *testing.T) {
+	errs := map[string][]string{"": {"form error"}, "email": {"is required"}}
+	if got := Messages(errs, "email"); len(got) != 1 || got[0] != "is required" {
+		t.Errorf("expected email messages, got %v", got)
+	}
+	if got := Messages(errs, ""); got != nil {
+		t.Errorf("expected no messages for unnamed control, got %v", got)
+	}
+	if got := Messages(nil, "email"); got != nil {
+		t.Errorf("expected no messages for nil errors, got %v", got)
+	}
+}
@@ -0,0 +1,69 @@
+// Package route provides active-route matching shared by navigation components.
+package route
+
+import "strings"
+
+// Match reports how well href matches the current request path.
+//
+// It returns 0 when href does not match. An exact match scores higher
+// than any prefix match, and longer prefixes score higher than shorter
+// ones, so callers can pick the most specific of several candidates.
+// Unless exact is set, href also matches any path below it, e.g.
+// "/users" matches "/users/42". The root "/" only ever matches exactly.
+func Match(href, path string, exact bool) int {
+	if href == "" {
+		return 0
+	}
+	href = trimSlash(href)
+	path = trimSlash(path)
+	if href == path {
+		return 2*len(href) + 1
+	}
+	if exact || href == "/" {
+		return 0
+	}
+	if strings.HasPrefix(path, href+"/") {
+		return 2 * len(href)
+	}
+	return 0
+}
+
+// Helper function to drop a trailing slash from anything but the root
+func trimSlash(s string) string {
+	if len(s) > 1 {
+		return strings.TrimSuffix(s, "/")
+	}
+	return s
+}
+
+// Trail returns the item of the trees in groups that best matches path,
+// together with all of its ancestors, keyed by their address in the
+// slices. The node function reports the href, exact flag and children
+// of an item; items reporting an empty href never match, and items
+// without children end the walk.
+func Trail[T any](groups [][]T, path string, node func(item *T) (href string, exact bool, children []T)) map[*T]bool {
+	var best []*T
+	bestScore := 0
+
+	var walk func(items []T, ancestors []*T)
+	walk = func(items []T, ancestors []*T) {
+		for i := range items {
+			item := &items[i]
+			href, exact, children := node(item)
+			trail := append(ancestors[:len(ancestors):len(ancestors)], item)
+			if score := Match(href, path, exact); score > bestScore {
+				best, bestScore = trail, score
+			}
+			walk(children, trail)
+		}
+	}
+	for _, items := range groups {
+		walk(items, nil)
+	}
+
+	result := make(map[*T]bool, len(best))
+	for _, item := range best {
+		result[item] = true
+	}
+	return result
+}
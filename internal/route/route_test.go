@@ -0,0 +1,84 @@
+package route
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		href  string
+		path  string
+		exact bool
+		match bool
+	}{
+		{name: "Exact match", href: "/users", path: "/users", match: true},
+		{name: "Trailing slash", href: "/users/", path: "/users", match: true},
+		{name: "Prefix match", href: "/users", path: "/users/42/edit", match: true},
+		{name: "Prefix disabled", href: "/users", path: "/users/42", exact: true},
+		{name: "Partial segment", href: "/user", path: "/users"},
+		{name: "Root matches only itself", href: "/", path: "/users"},
+		{name: "Root exact", href: "/", path: "/", match: true},
+		{name: "Empty href", href: "", path: "/"},
+		{name: "Different path", href: "/settings", path: "/users"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Match(tt.href, tt.path, tt.exact) > 0
+			if got != tt.match {
+				t.Errorf("expected match %v, got %v", tt.match, got)
+			}
+		})
+	}
+}
+
+func TestMatchPrefersMostSpecific(t *testing.T) {
+	path := "/users/new"
+	exact := Match("/users/new", path, false)
+	prefix := Match("/users", path, false)
+	if exact <= prefix {
+		t.Errorf("expected exact match (%d) to score higher than prefix match (%d)", exact, prefix)
+	}
+	if Match("/users", "/users", false) <= Match("/users", "/users/42", false) {
+		t.Errorf("expected exact match to score higher than prefix match of the same href")
+	}
+}
+
+func TestTrail(t *testing.T) {
+	type node struct {
+		href     string
+		children []node
+	}
+	groups := [][]node{
+		{{href: "/"}, {href: "/users", children: []node{{href: "/users/new"}, {href: ""}}}},
+		{{href: "/settings"}},
+	}
+	visit := func(n *node) (string, bool, []node) {
+		return n.href, n.href == "/", n.children
+	}
+
+	tests := []struct {
+		name   string
+		path   string
+		expect []*node
+	}{
+		{name: "Nested match includes ancestors", path: "/users/new", expect: []*node{&groups[0][1], &groups[0][1].children[0]}},
+		{name: "Prefix match", path: "/users/42", expect: []*node{&groups[0][1]}},
+		{name: "Second group", path: "/settings", expect: []*node{&groups[1][0]}},
+		{name: "Exact root", path: "/", expect: []*node{&groups[0][0]}},
+		{name: "No match", path: "/about"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Trail(groups, tt.path, visit)
+			if len(got) != len(tt.expect) {
+				t.Fatalf("expected %d items, got %d", len(tt.expect), len(got))
+			}
+			for _, n := range tt.expect {
+				if !got[n] {
+					t.Errorf("expected %q in trail", n.href)
+				}
+			}
+		})
+	}
+}
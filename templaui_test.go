@@ -2,10 +2,14 @@ package templaui
 
 import (
 	"context"
+	"errors"
+	"io/fs"
 	"strings"
 	"testing"
 
 	"github.com/a-h/templ"
+
+	"github.com/alexferl/templaui/assets"
 )
 
 func TestDocument(t *testing.T) {
@@ -93,6 +97,27 @@ func TestDocument(t *testing.T) {
 				`<link rel="icon" href="/my-favicon.ico">`,
 			},
 		},
+		{
+			name: "With theme mode and script",
+			props: DocumentProps{
+				ThemeMode:   ThemeDark,
+				ThemeScript: true,
+			},
+			contains: []string{
+				`<html lang="en" data-theme="dark">`,
+				`<link rel="icon" href="data:,"><script>`,
+				`[data-theme-toggle]`,
+			},
+		},
+		{
+			name: "With system theme mode",
+			props: DocumentProps{
+				ThemeMode: ThemeSystem,
+			},
+			contains: []string{
+				`<html lang="en">`,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -113,6 +138,23 @@ func TestDocument(t *testing.T) {
 	}
 }
 
+func TestDocumentCharsetWithinPrescan(t *testing.T) {
+	var buf strings.Builder
+	err := Document(DocumentProps{ThemeScript: true}).Render(context.Background(), &buf)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	got := buf.String()
+
+	// Browsers only look for the charset in the first 1024 bytes
+	if i := strings.Index(got, `<meta charset="UTF-8">`); i < 0 || i+len(`<meta charset="UTF-8">`) > 1024 {
+		t.Errorf("expected charset within the first 1024 bytes, found at %d", i)
+	}
+	if i, j := strings.Index(got, "<script>"), strings.Index(got, `rel="stylesheet"`); i < 0 || j < i {
+		t.Errorf("expected theme script before the stylesheet, got: %s", got)
+	}
+}
+
 func TestDocumentWithAttributes(t *testing.T) {
 	props := DocumentProps{
 		Title:     "Test App",
@@ -696,6 +738,36 @@ func TestBulmaCSS(t *testing.T) {
 				`<link rel="stylesheet" href="https://cdnjs.cloudflare.com/ajax/libs/bulma/1.0.4/css/bulma.min.css"`,
 			},
 		},
+		{
+			name: "jsDelivr with preload",
+			config: BulmaConfig{
+				CDN:     JSDelivr,
+				Preload: true,
+			},
+			contains: []string{
+				`<link rel="preconnect" href="https://cdn.jsdelivr.net" crossorigin="anonymous">`,
+				`<link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/bulma@1.0.4/css/bulma.min.css" integrity="sha512-yh2RE0wZCVZeysGiqTwDTO/dKelCbS9bP2L94UvOFtl/FKXcNAje3Y2oBg/ZMZ3LS1sicYk4dYVGtDex75fvvA=="`,
+			},
+		},
+		{
+			name: "unpkg",
+			config: BulmaConfig{
+				CDN: Unpkg,
+			},
+			contains: []string{
+				`<link rel="stylesheet" href="https://unpkg.com/bulma@1.0.4/css/bulma.min.css" integrity="sha512-`,
+			},
+		},
+		{
+			name: "Unregistered version with explicit integrity",
+			config: BulmaConfig{
+				Version:   "1.0.2",
+				Integrity: "sha512-custom",
+			},
+			contains: []string{
+				`href="https://cdnjs.cloudflare.com/ajax/libs/bulma/1.0.2/css/bulma.min.css" integrity="sha512-custom"`,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -716,6 +788,60 @@ func TestBulmaCSS(t *testing.T) {
 	}
 }
 
+func TestBulmaCSSErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		config BulmaConfig
+		err    error
+	}{
+		{
+			name:   "Unknown version",
+			config: BulmaConfig{Version: "0.0.1"},
+			err:    ErrUnknownBulmaVersion,
+		},
+		{
+			name:   "Unknown CDN",
+			config: BulmaConfig{CDN: "example"},
+			err:    ErrUnknownCDN,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			err := BulmaCSS(tt.config).Render(context.Background(), &buf)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("expected error %v, got %v", tt.err, err)
+			}
+			if strings.Contains(buf.String(), "integrity") {
+				t.Errorf("expected no integrity attribute, got: %s", buf.String())
+			}
+		})
+	}
+}
+
+func TestBulmaCSSEmbedded(t *testing.T) {
+	var buf strings.Builder
+	err := BulmaCSS(BulmaConfig{UseEmbedded: true, UseLocal: true}).Render(context.Background(), &buf)
+	href, pathErr := assets.Path(assets.Bulma)
+	if pathErr != nil {
+		// Without an embedded stylesheet rendering fails instead of linking a missing file
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("expected error wrapping fs.ErrNotExist, got %v", err)
+		}
+		if strings.TrimSpace(buf.String()) != "" {
+			t.Errorf("expected no link, got: %s", buf.String())
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if expect := `<link rel="stylesheet" href="` + href + `">`; strings.TrimSpace(buf.String()) != expect {
+		t.Errorf("expected:\n%s\ngot:\n%s", expect, buf.String())
+	}
+}
+
 func TestHTML(t *testing.T) {
 	tests := []struct {
 		name         string
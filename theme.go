@@ -0,0 +1,305 @@
+package templaui
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidTheme is returned when a Theme holds a value that can't be
+// turned into Bulma CSS variables.
+var ErrInvalidTheme = errors.New("templaui: invalid theme")
+
+// ThemeColors defines the colors of a Bulma theme. Colors are CSS colors
+// in hex (#00d1b2 or #0db), rgb() or hsl() notation; empty colors keep
+// Bulma's defaults.
+type ThemeColors struct {
+	// Primary brand color
+	Primary string
+	// Link color
+	Link string
+	// Info color
+	Info string
+	// Success color
+	Success string
+	// Warning color
+	Warning string
+	// Danger color
+	Danger string
+	// Color whose hue and saturation tint the scheme (backgrounds, borders and text)
+	Scheme string
+}
+
+// Theme customizes Bulma through its --bulma-* CSS variables.
+// Render it with ThemeStyle after the Bulma stylesheet.
+type Theme struct {
+	ThemeColors
+	// Color overrides applied in dark mode
+	Dark ThemeColors
+	// Default border radius (e.g. "0.5rem")
+	Radius string
+	// Small border radius
+	RadiusSmall string
+	// Large border radius
+	RadiusLarge string
+	// Primary font family (e.g. `"Inter", sans-serif`)
+	Family string
+	// Monospace font family used for code
+	FamilyCode string
+}
+
+// HSL is a color split into the hue, saturation and lightness components
+// Bulma expects in its -h, -s and -l variables.
+type HSL struct {
+	// Hue in degrees, from 0 to 360
+	H float64
+	// Saturation in percent, from 0 to 100
+	S float64
+	// Lightness in percent, from 0 to 100
+	L float64
+}
+
+// ParseColor parses a CSS color in hex, rgb() or hsl() notation into its
+// HSL components.
+func ParseColor(color string) (HSL, error) {
+	s := strings.ToLower(strings.TrimSpace(color))
+	switch {
+	case strings.HasPrefix(s, "#"):
+		return parseHex(s[1:], color)
+	case strings.HasPrefix(s, "rgb(") && strings.HasSuffix(s, ")"):
+		v, err := parseComponents(s[4:len(s)-1], color)
+		if err != nil {
+			return HSL{}, err
+		}
+		for _, c := range v {
+			if c < 0 || c > 255 {
+				return HSL{}, fmt.Errorf("%w: color %q is out of range", ErrInvalidTheme, color)
+			}
+		}
+		return rgbToHSL(v[0], v[1], v[2]), nil
+	case strings.HasPrefix(s, "hsl(") && strings.HasSuffix(s, ")"):
+		v, err := parseComponents(strings.NewReplacer("deg", "", "%", "").Replace(s[4:len(s)-1]), color)
+		if err != nil {
+			return HSL{}, err
+		}
+		if v[1] < 0 || v[1] > 100 || v[2] < 0 || v[2] > 100 {
+			return HSL{}, fmt.Errorf("%w: color %q is out of range", ErrInvalidTheme, color)
+		}
+		return HSL{H: math.Mod(math.Mod(v[0], 360)+360, 360), S: v[1], L: v[2]}, nil
+	}
+	return HSL{}, fmt.Errorf("%w: unsupported color %q", ErrInvalidTheme, color)
+}
+
+// CSS returns the style sheet setting the theme's Bulma variables on
+// :root and, for the dark overrides, on [data-theme=dark] and in the
+// prefers-color-scheme: dark media query unless light mode is forced.
+func (t Theme) CSS() (string, error) {
+	light, err := themeDeclarations(t.ThemeColors)
+	if err != nil {
+		return "", err
+	}
+	for _, v := range []struct{ name, value string }{
+		{"radius", t.Radius},
+		{"radius-small", t.RadiusSmall},
+		{"radius-large", t.RadiusLarge},
+		{"family-primary", t.Family},
+		{"family-code", t.FamilyCode},
+	} {
+		if v.value == "" {
+			continue
+		}
+		if !isSafeCSSValue(v.value) {
+			return "", fmt.Errorf("%w: invalid %s value %q", ErrInvalidTheme, v.name, v.value)
+		}
+		light = append(light, "--bulma-"+v.name+": "+v.value)
+	}
+	dark, err := themeDeclarations(t.Dark)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	if len(light) > 0 {
+		writeRule(&b, "", ":root, [data-theme=light], .theme-light", light)
+	}
+	if len(dark) > 0 {
+		b.WriteString("@media (prefers-color-scheme: dark) {\n")
+		writeRule(&b, "\t", ":root:not([data-theme=light])", dark)
+		b.WriteString("}\n")
+		writeRule(&b, "", "[data-theme=dark], .theme-dark", dark)
+	}
+	return b.String(), nil
+}
+
+// Helper function to return the variable declarations of theme colors
+func themeDeclarations(c ThemeColors) ([]string, error) {
+	var decls []string
+	for _, v := range []struct{ name, color string }{
+		{"primary", c.Primary},
+		{"link", c.Link},
+		{"info", c.Info},
+		{"success", c.Success},
+		{"warning", c.Warning},
+		{"danger", c.Danger},
+	} {
+		if v.color == "" {
+			continue
+		}
+		hsl, err := ParseColor(v.color)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", v.name, err)
+		}
+		decls = append(decls,
+			"--bulma-"+v.name+"-h: "+formatNumber(hsl.H)+"deg",
+			"--bulma-"+v.name+"-s: "+formatNumber(hsl.S)+"%",
+			"--bulma-"+v.name+"-l: "+formatNumber(hsl.L)+"%",
+		)
+	}
+	if c.Scheme != "" {
+		hsl, err := ParseColor(c.Scheme)
+		if err != nil {
+			return nil, fmt.Errorf("scheme: %w", err)
+		}
+		decls = append(decls,
+			"--bulma-scheme-h: "+formatNumber(hsl.H),
+			"--bulma-scheme-s: "+formatNumber(hsl.S)+"%",
+		)
+	}
+	return decls, nil
+}
+
+// Helper function to write a CSS rule
+func writeRule(b *strings.Builder, indent, selector string, decls []string) {
+	b.WriteString(indent + selector + " {\n")
+	for _, d := range decls {
+		b.WriteString(indent + "\t" + d + ";\n")
+	}
+	b.WriteString(indent + "}\n")
+}
+
+// Helper function to parse a hex color
+func parseHex(hex, color string) (HSL, error) {
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return HSL{}, fmt.Errorf("%w: invalid hex color %q", ErrInvalidTheme, color)
+	}
+	n, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return HSL{}, fmt.Errorf("%w: invalid hex color %q", ErrInvalidTheme, color)
+	}
+	return rgbToHSL(float64(n>>16&0xff), float64(n>>8&0xff), float64(n&0xff)), nil
+}
+
+// Helper function to parse the three comma or space separated components of a color function
+func parseComponents(s, color string) ([3]float64, error) {
+	var v [3]float64
+	fields := strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' })
+	if len(fields) != 3 {
+		return v, fmt.Errorf("%w: invalid color %q", ErrInvalidTheme, color)
+	}
+	for i, f := range fields {
+		n, err := strconv.ParseFloat(f, 64)
+		if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+			return v, fmt.Errorf("%w: invalid color %q", ErrInvalidTheme, color)
+		}
+		v[i] = n
+	}
+	return v, nil
+}
+
+// Helper function to convert RGB components from 0 to 255 to HSL
+func rgbToHSL(r, g, b float64) HSL {
+	r, g, b = r/255, g/255, b/255
+	maxC := max(r, g, b)
+	minC := min(r, g, b)
+	l := (maxC + minC) / 2
+	if maxC == minC {
+		return HSL{L: l * 100}
+	}
+
+	d := maxC - minC
+	s := d / (1 - math.Abs(2*l-1))
+	var h float64
+	switch maxC {
+	case r:
+		h = math.Mod((g-b)/d, 6)
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+	return HSL{H: h, S: s * 100, L: l * 100}
+}
+
+// Helper function to format a number with at most one decimal
+func formatNumber(n float64) string {
+	return strconv.FormatFloat(math.Round(n*10)/10, 'f', -1, 64)
+}
+
+// Helper function to reject values that could break out of a CSS declaration
+func isSafeCSSValue(s string) bool {
+	return !strings.ContainsAny(s, ";{}<>\\\n\r")
+}
+
+// ThemeMode is a light or dark theme preference.
+type ThemeMode string
+
+const (
+	ThemeSystem ThemeMode = "system" // Follow the operating system preference
+	ThemeLight  ThemeMode = "light"  // Force the light theme
+	ThemeDark   ThemeMode = "dark"   // Force the dark theme
+)
+
+// ThemeCookieName is the name of the cookie storing the theme preference
+// set by ThemeToggle and read by ThemeFromRequest.
+const ThemeCookieName = "theme"
+
+// ThemeFromRequest returns the theme preference stored in the request's
+// theme cookie, or ThemeSystem when there is none. Pass it to
+// DocumentProps.ThemeMode so pages are rendered in the chosen theme.
+func ThemeFromRequest(r *http.Request) ThemeMode {
+	c, err := r.Cookie(ThemeCookieName)
+	if err != nil {
+		return ThemeSystem
+	}
+	switch mode := ThemeMode(c.Value); mode {
+	case ThemeLight, ThemeDark:
+		return mode
+	}
+	return ThemeSystem
+}
+
+// SetThemeCookie stores a theme preference in the theme cookie, or
+// deletes the cookie for ThemeSystem, e.g. from a handler receiving a
+// theme form submitted without JavaScript.
+func SetThemeCookie(w http.ResponseWriter, mode ThemeMode) {
+	c := &http.Cookie{
+		Name:     ThemeCookieName,
+		Value:    string(mode),
+		Path:     "/",
+		MaxAge:   365 * 24 * 60 * 60,
+		SameSite: http.SameSiteLaxMode,
+	}
+	if mode != ThemeLight && mode != ThemeDark {
+		c.Value = ""
+		c.MaxAge = -1
+	}
+	http.SetCookie(w, c)
+}
+
+// Helper function to return the data-theme attribute value of a theme mode
+func dataTheme(mode ThemeMode) string {
+	if mode == ThemeLight || mode == ThemeDark {
+		return string(mode)
+	}
+	return ""
+}
@@ -0,0 +1,345 @@
+// Code generated by templ - DO NOT EDIT.
+
+// templ: version: v0.3.943
+package templaui
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import templruntime "github.com/a-h/templ/runtime"
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/alexferl/templaui/internal/csp"
+)
+
+// ThemeStyle renders a style element applying a Theme.
+//
+// Place it in the document head after BulmaCSS, e.g. as head content of
+// Document. The style element carries the request's CSP nonce when one
+// is set with templ.WithNonce. Rendering fails with ErrInvalidTheme when
+// the theme holds an invalid color or value, and nothing is rendered for
+// an empty theme.
+func ThemeStyle(theme Theme) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var1 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var1 == nil {
+			templ_7745c5c3_Var1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		css, err := theme.CSS()
+		if err != nil {
+			return err
+		}
+		if css != "" {
+			templ_7745c5c3_Err = themeStyleElement(css).Render(ctx, templ_7745c5c3_Buffer)
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		return nil
+	})
+}
+
+// Helper function to render a style element holding generated CSS. templ
+// keeps the contents of style elements as raw text, so the CSS can't be
+// interpolated in the template; the opening tag is rendered with the same
+// nonce attributes as the other inline styles.
+func themeStyleElement(css string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		if _, err := io.WriteString(w, "<style"); err != nil {
+			return err
+		}
+		if err := templ.RenderAttributes(ctx, w, csp.NonceAttrs(ctx)); err != nil {
+			return err
+		}
+		_, err := io.WriteString(w, ">\n"+css+"</style>")
+		return err
+	})
+}
+
+// ThemeToggleProps defines configuration for theme toggle buttons.
+// Use this type to configure buttons switching between Bulma's light
+// and dark themes, or selecting a given theme when Value is set.
+type ThemeToggleProps struct {
+	// Optional HTML id attribute for the button
+	ID string
+
+	// List of additional CSS classes to apply to the button
+	Class []string
+
+	// Additional arbitrary HTML attributes
+	Attributes templ.Attributes
+
+	// Theme selected by the button; empty toggles between light and dark
+	Value ThemeMode
+
+	// Accessible label of the button (defaults to "Toggle dark mode")
+	Label string
+
+	// Current theme preference, e.g. from ThemeFromRequest, used to render the pressed state
+	Mode ThemeMode
+}
+
+// ThemeToggle renders buttons switching between light and dark themes.
+//
+// This component renders a Bulma .button with a data-theme-toggle
+// attribute handled by ThemeScript, which sets data-theme on the html
+// element and stores the preference in the theme cookie read by
+// ThemeFromRequest. Without Value the button toggles between light and
+// dark and is pressed in dark mode; with Value it selects that theme, or
+// follows the system preference for ThemeSystem, and is pressed while
+// that preference is active. The icon or text should be provided as
+// children content. ThemeScript is included once per request; render it
+// in the document head as well to apply the theme before first paint.
+func ThemeToggle(props ...ThemeToggleProps) templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var2 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var2 == nil {
+			templ_7745c5c3_Var2 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		var p ThemeToggleProps
+		if len(props) > 0 {
+			p = props[0]
+		}
+		templ_7745c5c3_Err = ThemeScript().Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var3 = []any{"button",
+			templ.KV(strings.Join(p.Class, " "), len(p.Class) > 0),
+		}
+		templ_7745c5c3_Err = templ.RenderCSSItems(ctx, templ_7745c5c3_Buffer, templ_7745c5c3_Var3...)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<button")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if p.ID != "" {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 2, " id=\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var4 string
+			templ_7745c5c3_Var4, templ_7745c5c3_Err = templ.JoinStringErrs(p.ID)
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `theme.templ`, Line: 88, Col: 12}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var4))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 3, "\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 4, " type=\"button\" class=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var5 string
+		templ_7745c5c3_Var5, templ_7745c5c3_Err = templ.JoinStringErrs(templ.CSSClasses(templ_7745c5c3_Var3).String())
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `theme.templ`, Line: 1, Col: 0}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var5))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 5, "\" data-theme-toggle=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var6 string
+		templ_7745c5c3_Var6, templ_7745c5c3_Err = templ.JoinStringErrs(string(p.Value))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `theme.templ`, Line: 95, Col: 37}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var6))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 6, "\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		if label := themeToggleLabel(p); label != "" {
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 7, " aria-label=\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			var templ_7745c5c3_Var7 string
+			templ_7745c5c3_Var7, templ_7745c5c3_Err = templ.JoinStringErrs(label)
+			if templ_7745c5c3_Err != nil {
+				return templ.Error{Err: templ_7745c5c3_Err, FileName: `theme.templ`, Line: 97, Col: 21}
+			}
+			_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var7))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 8, "\"")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 9, " aria-pressed=\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		var templ_7745c5c3_Var8 string
+		templ_7745c5c3_Var8, templ_7745c5c3_Err = templ.JoinStringErrs(themeTogglePressed(p))
+		if templ_7745c5c3_Err != nil {
+			return templ.Error{Err: templ_7745c5c3_Err, FileName: `theme.templ`, Line: 99, Col: 38}
+		}
+		_, templ_7745c5c3_Err = templ_7745c5c3_Buffer.WriteString(templ.EscapeString(templ_7745c5c3_Var8))
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 10, "\"")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templ.RenderAttributes(ctx, templ_7745c5c3_Buffer, p.Attributes)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 11, ">")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templ_7745c5c3_Var2.Render(ctx, templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 12, "</button>")
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+// Helper function to return the accessible label of a theme toggle
+func themeToggleLabel(p ThemeToggleProps) string {
+	if p.Label == "" && p.Value == "" {
+		return "Toggle dark mode"
+	}
+	return p.Label
+}
+
+// Helper function to return the pressed state of a theme toggle for the current preference
+func themeTogglePressed(p ThemeToggleProps) string {
+	mode := p.Mode
+	if mode == "" {
+		mode = ThemeSystem
+	}
+	if p.Value == "" {
+		return strconv.FormatBool(mode == ThemeDark)
+	}
+	return strconv.FormatBool(p.Value == mode)
+}
+
+var themeScriptHandle = templ.NewOnceHandle()
+
+// ThemeScript renders the script applying and persisting theme preferences.
+//
+// Render it early in the document head: it runs before the page is
+// painted, setting data-theme on the html element from the theme cookie
+// so pages don't flash in the wrong theme, and handles clicks on
+// ThemeToggle buttons, keeping their aria-pressed state in sync. The
+// preference is stored for a year in the theme cookie, which is deleted
+// when the system preference is selected. The script is rendered once
+// per request and carries the request's CSP nonce when one is set with
+// templ.WithNonce.
+func ThemeScript() templ.Component {
+	return templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+		templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+		if templ_7745c5c3_CtxErr := ctx.Err(); templ_7745c5c3_CtxErr != nil {
+			return templ_7745c5c3_CtxErr
+		}
+		templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+		if !templ_7745c5c3_IsBuffer {
+			defer func() {
+				templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+				if templ_7745c5c3_Err == nil {
+					templ_7745c5c3_Err = templ_7745c5c3_BufErr
+				}
+			}()
+		}
+		ctx = templ.InitializeContext(ctx)
+		templ_7745c5c3_Var9 := templ.GetChildren(ctx)
+		if templ_7745c5c3_Var9 == nil {
+			templ_7745c5c3_Var9 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		templ_7745c5c3_Var10 := templruntime.GeneratedTemplate(func(templ_7745c5c3_Input templruntime.GeneratedComponentInput) (templ_7745c5c3_Err error) {
+			templ_7745c5c3_W, ctx := templ_7745c5c3_Input.Writer, templ_7745c5c3_Input.Context
+			templ_7745c5c3_Buffer, templ_7745c5c3_IsBuffer := templruntime.GetBuffer(templ_7745c5c3_W)
+			if !templ_7745c5c3_IsBuffer {
+				defer func() {
+					templ_7745c5c3_BufErr := templruntime.ReleaseBuffer(templ_7745c5c3_Buffer)
+					if templ_7745c5c3_Err == nil {
+						templ_7745c5c3_Err = templ_7745c5c3_BufErr
+					}
+				}()
+			}
+			ctx = templ.InitializeContext(ctx)
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 13, "<script")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templ.RenderAttributes(ctx, templ_7745c5c3_Buffer, csp.NonceAttrs(ctx))
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 14, ">\n\t\t\t(() => {\n\t\t\t\tconst root = document.documentElement;\n\t\t\t\tconst dark = window.matchMedia(\"(prefers-color-scheme: dark)\");\n\t\t\t\tconst read = () => {\n\t\t\t\t\tconst match = document.cookie.match(/(?:^|;\\s*)theme=(light|dark)(?:;|$)/);\n\t\t\t\t\treturn match ? match[1] : \"\";\n\t\t\t\t};\n\t\t\t\tconst effective = () => root.dataset.theme || (dark.matches ? \"dark\" : \"light\");\n\t\t\t\tconst sync = () => {\n\t\t\t\t\tconst mode = read() || \"system\";\n\t\t\t\t\tdocument.querySelectorAll(\"[data-theme-toggle]\").forEach((button) => {\n\t\t\t\t\t\tconst value = button.dataset.themeToggle;\n\t\t\t\t\t\tbutton.setAttribute(\"aria-pressed\", String(value ? value === mode : effective() === \"dark\"));\n\t\t\t\t\t});\n\t\t\t\t};\n\t\t\t\tconst apply = (mode) => {\n\t\t\t\t\tconst secure = location.protocol === \"https:\" ? \"; secure\" : \"\";\n\t\t\t\t\tif (mode === \"light\" || mode === \"dark\") {\n\t\t\t\t\t\troot.dataset.theme = mode;\n\t\t\t\t\t\tdocument.cookie = `theme=${mode}; path=/; max-age=31536000; samesite=lax${secure}`;\n\t\t\t\t\t} else {\n\t\t\t\t\t\tdelete root.dataset.theme;\n\t\t\t\t\t\tdocument.cookie = `theme=; path=/; max-age=0; samesite=lax${secure}`;\n\t\t\t\t\t}\n\t\t\t\t\tsync();\n\t\t\t\t};\n\t\t\t\tconst saved = read();\n\t\t\t\tif (saved) root.dataset.theme = saved;\n\t\t\t\tdocument.addEventListener(\"click\", (event) => {\n\t\t\t\t\tconst button = event.target instanceof Element ? event.target.closest(\"[data-theme-toggle]\") : null;\n\t\t\t\t\tif (!button) return;\n\t\t\t\t\tapply(button.dataset.themeToggle || (effective() === \"dark\" ? \"light\" : \"dark\"));\n\t\t\t\t});\n\t\t\t\tdark.addEventListener(\"change\", sync);\n\t\t\t\tif (document.readyState === \"loading\") {\n\t\t\t\t\tdocument.addEventListener(\"DOMContentLoaded\", sync);\n\t\t\t\t} else {\n\t\t\t\t\tsync();\n\t\t\t\t}\n\t\t\t})();\n\t\t</script>")
+			if templ_7745c5c3_Err != nil {
+				return templ_7745c5c3_Err
+			}
+			return nil
+		})
+		templ_7745c5c3_Err = themeScriptHandle.Once().Render(templ.WithChildren(ctx, templ_7745c5c3_Var10), templ_7745c5c3_Buffer)
+		if templ_7745c5c3_Err != nil {
+			return templ_7745c5c3_Err
+		}
+		return nil
+	})
+}
+
+var _ = templruntime.GeneratedTemplate
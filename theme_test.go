@@ -0,0 +1,341 @@
+package templaui
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		color  string
+		expect HSL
+	}{
+		{color: "#00d1b2", expect: HSL{H: 171.1, S: 100, L: 41}},
+		{color: "#0DB", expect: HSL{H: 170.8, S: 100, L: 43.3}},
+		{color: "#ffffff", expect: HSL{H: 0, S: 0, L: 100}},
+		{color: "rgb(72, 95, 199)", expect: HSL{H: 229.1, S: 53.1, L: 53.1}},
+		{color: "rgb(255 0 0)", expect: HSL{H: 0, S: 100, L: 50}},
+		{color: " hsl(348deg, 100%, 61%) ", expect: HSL{H: 348, S: 100, L: 61}},
+		{color: "hsl(-10 50% 50%)", expect: HSL{H: 350, S: 50, L: 50}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.color, func(t *testing.T) {
+			got, err := ParseColor(tt.color)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			rounded := HSL{H: round1(got.H), S: round1(got.S), L: round1(got.L)}
+			if rounded != tt.expect {
+				t.Errorf("expected %+v, got %+v", tt.expect, rounded)
+			}
+		})
+	}
+}
+
+func TestParseColorInvalid(t *testing.T) {
+	for _, color := range []string{"", "red", "#12", "#12345g", "#00d1b2ff", "rgb(0, 0)", "rgb(0, 0, 300)", "rgb(a, b, c)", "hsl(0, 120%, 50%)", "url(x)"} {
+		t.Run(color, func(t *testing.T) {
+			if _, err := ParseColor(color); !errors.Is(err, ErrInvalidTheme) {
+				t.Errorf("expected ErrInvalidTheme, got %v", err)
+			}
+		})
+	}
+}
+
+func TestThemeCSS(t *testing.T) {
+	tests := []struct {
+		name   string
+		theme  Theme
+		expect string
+	}{
+		{
+			name:   "Empty",
+			theme:  Theme{},
+			expect: ``,
+		},
+		{
+			name: "Colors, radius and family",
+			theme: Theme{
+				ThemeColors: ThemeColors{Primary: "#00d1b2", Scheme: "hsl(221, 14%, 50%)"},
+				Radius:      "0.5rem",
+				Family:      `"Inter", sans-serif`,
+			},
+			expect: ":root, [data-theme=light], .theme-light {\n" +
+				"\t--bulma-primary-h: 171.1deg;\n" +
+				"\t--bulma-primary-s: 100%;\n" +
+				"\t--bulma-primary-l: 41%;\n" +
+				"\t--bulma-scheme-h: 221;\n" +
+				"\t--bulma-scheme-s: 14%;\n" +
+				"\t--bulma-radius: 0.5rem;\n" +
+				"\t--bulma-family-primary: \"Inter\", sans-serif;\n" +
+				"}\n",
+		},
+		{
+			name:  "Dark overrides",
+			theme: Theme{Dark: ThemeColors{Link: "rgb(255, 0, 0)"}},
+			expect: "@media (prefers-color-scheme: dark) {\n" +
+				"\t:root:not([data-theme=light]) {\n" +
+				"\t\t--bulma-link-h: 0deg;\n" +
+				"\t\t--bulma-link-s: 100%;\n" +
+				"\t\t--bulma-link-l: 50%;\n" +
+				"\t}\n" +
+				"}\n" +
+				"[data-theme=dark], .theme-dark {\n" +
+				"\t--bulma-link-h: 0deg;\n" +
+				"\t--bulma-link-s: 100%;\n" +
+				"\t--bulma-link-l: 50%;\n" +
+				"}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.theme.CSS()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestThemeCSSInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		theme Theme
+	}{
+		{name: "Invalid color", theme: Theme{ThemeColors: ThemeColors{Danger: "crimson"}}},
+		{name: "Invalid dark color", theme: Theme{Dark: ThemeColors{Scheme: "#xyz"}}},
+		{name: "Unsafe radius", theme: Theme{Radius: "4px; } body { display: none"}},
+		{name: "Unsafe family", theme: Theme{Family: "</style><script>"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.theme.CSS(); !errors.Is(err, ErrInvalidTheme) {
+				t.Errorf("expected ErrInvalidTheme, got %v", err)
+			}
+		})
+	}
+}
+
+func TestThemeStyle(t *testing.T) {
+	theme := Theme{ThemeColors: ThemeColors{Primary: "#00d1b2"}}
+
+	t.Run("Without nonce", func(t *testing.T) {
+		var buf strings.Builder
+		err := ThemeStyle(theme).Render(context.Background(), &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		got := buf.String()
+		if !strings.HasPrefix(got, "<style>\n:root") || !strings.HasSuffix(got, "}\n</style>") {
+			t.Errorf("expected theme style element, got: %s", got)
+		}
+		if !strings.Contains(got, "--bulma-primary-h: 171.1deg;") {
+			t.Errorf("expected primary hue, got: %s", got)
+		}
+	})
+
+	t.Run("With nonce", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.WithNonce(context.Background(), "r4nd0m")
+		err := ThemeStyle(theme).Render(ctx, &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		if !strings.HasPrefix(buf.String(), `<style nonce="r4nd0m">`) {
+			t.Errorf("expected style with nonce, got: %s", buf.String())
+		}
+	})
+
+	t.Run("Empty theme", func(t *testing.T) {
+		var buf strings.Builder
+		err := ThemeStyle(Theme{}).Render(context.Background(), &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		if buf.Len() != 0 {
+			t.Errorf("expected no output, got: %s", buf.String())
+		}
+	})
+
+	t.Run("Invalid theme", func(t *testing.T) {
+		var buf strings.Builder
+		err := ThemeStyle(Theme{ThemeColors: ThemeColors{Info: "blue"}}).Render(context.Background(), &buf)
+		if !errors.Is(err, ErrInvalidTheme) {
+			t.Errorf("expected ErrInvalidTheme, got %v", err)
+		}
+		if buf.Len() != 0 {
+			t.Errorf("expected no output, got: %s", buf.String())
+		}
+	})
+}
+
+func round1(n float64) float64 {
+	return float64(int(n*10+0.5)) / 10
+}
+
+func TestThemeFromRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		cookie string
+		expect ThemeMode
+	}{
+		{name: "No cookie", expect: ThemeSystem},
+		{name: "Light", cookie: "light", expect: ThemeLight},
+		{name: "Dark", cookie: "dark", expect: ThemeDark},
+		{name: "Invalid", cookie: "purple", expect: ThemeSystem},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.cookie != "" {
+				r.AddCookie(&http.Cookie{Name: ThemeCookieName, Value: tt.cookie})
+			}
+			if got := ThemeFromRequest(r); got != tt.expect {
+				t.Errorf("expected %q, got %q", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestSetThemeCookie(t *testing.T) {
+	tests := []struct {
+		mode   ThemeMode
+		value  string
+		maxAge int
+	}{
+		{mode: ThemeDark, value: "dark", maxAge: 365 * 24 * 60 * 60},
+		{mode: ThemeLight, value: "light", maxAge: 365 * 24 * 60 * 60},
+		{mode: ThemeSystem, value: "", maxAge: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			w := httptest.NewRecorder()
+			SetThemeCookie(w, tt.mode)
+			cookies := w.Result().Cookies()
+			if len(cookies) != 1 {
+				t.Fatalf("expected one cookie, got %d", len(cookies))
+			}
+			c := cookies[0]
+			if c.Name != ThemeCookieName || c.Value != tt.value || c.MaxAge != tt.maxAge || c.Path != "/" {
+				t.Errorf("unexpected cookie: %+v", c)
+			}
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.AddCookie(c)
+			if got := ThemeFromRequest(r); got != tt.mode {
+				t.Errorf("expected cookie to round trip to %q, got %q", tt.mode, got)
+			}
+		})
+	}
+}
+
+func TestThemeToggle(t *testing.T) {
+	tests := []struct {
+		name   string
+		props  ThemeToggleProps
+		expect string
+	}{
+		{
+			name:   "Default",
+			props:  ThemeToggleProps{},
+			expect: `<button type="button" class="button" data-theme-toggle="" aria-label="Toggle dark mode" aria-pressed="false">◐</button>`,
+		},
+		{
+			name:   "Pressed in dark mode",
+			props:  ThemeToggleProps{Mode: ThemeDark, Label: "Dark mode"},
+			expect: `<button type="button" class="button" data-theme-toggle="" aria-label="Dark mode" aria-pressed="true">◐</button>`,
+		},
+		{
+			name:   "Theme value",
+			props:  ThemeToggleProps{Value: ThemeLight, Mode: ThemeLight},
+			expect: `<button type="button" class="button" data-theme-toggle="light" aria-pressed="true">◐</button>`,
+		},
+		{
+			name:   "System value pressed without preference",
+			props:  ThemeToggleProps{Value: ThemeSystem, Label: "Use system theme"},
+			expect: `<button type="button" class="button" data-theme-toggle="system" aria-label="Use system theme" aria-pressed="true">◐</button>`,
+		},
+		{
+			name:   "Custom ID, class and attributes",
+			props:  ThemeToggleProps{ID: "theme", Class: []string{"is-ghost"}, Attributes: templ.Attributes{"title": "Theme"}},
+			expect: `<button id="theme" type="button" class="button is-ghost" data-theme-toggle="" aria-label="Toggle dark mode" aria-pressed="false" title="Theme">◐</button>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			ctx := templ.InitializeContext(context.Background())
+			// Render the shared script first so only the button is compared
+			if err := ThemeScript().Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			buf.Reset()
+			ctx = templ.WithChildren(ctx, templ.Raw("◐"))
+			err := ThemeToggle(tt.props).Render(ctx, &buf)
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			got := strings.TrimSpace(buf.String())
+			if got != tt.expect {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestThemeScript(t *testing.T) {
+	t.Run("Without nonce", func(t *testing.T) {
+		var buf strings.Builder
+		err := ThemeScript().Render(context.Background(), &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		got := buf.String()
+		if !strings.HasPrefix(got, "<script>") {
+			t.Errorf("expected script without nonce, got: %s", got)
+		}
+		if !strings.Contains(got, "root.dataset.theme = saved") {
+			t.Errorf("expected script to apply the saved theme, got: %s", got)
+		}
+	})
+
+	t.Run("With nonce", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.WithNonce(context.Background(), "r4nd0m")
+		err := ThemeScript().Render(ctx, &buf)
+		if err != nil {
+			t.Fatalf("render failed: %v", err)
+		}
+		if !strings.HasPrefix(buf.String(), `<script nonce="r4nd0m">`) {
+			t.Errorf("expected script with nonce, got: %s", buf.String())
+		}
+	})
+
+	t.Run("Rendered once per context", func(t *testing.T) {
+		var buf strings.Builder
+		ctx := templ.InitializeContext(context.Background())
+		for range 2 {
+			if err := ThemeScript().Render(ctx, &buf); err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+		}
+		if n := strings.Count(buf.String(), "<script"); n != 1 {
+			t.Errorf("expected script to be rendered once, got %d", n)
+		}
+	})
+}